@@ -0,0 +1,101 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package api contains the messages and the service definition described in protodot.proto
+package api
+
+import (
+	"context"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+type RenderRequest struct {
+	Source    string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Selection string `protobuf:"bytes,2,opt,name=selection,proto3" json:"selection,omitempty"`
+	Svg       bool   `protobuf:"varint,3,opt,name=svg,proto3" json:"svg,omitempty"`
+}
+
+func (m *RenderRequest) Reset()         { *m = RenderRequest{} }
+func (m *RenderRequest) String() string { return proto.CompactTextString(m) }
+func (*RenderRequest) ProtoMessage()    {}
+
+type RenderResponse struct {
+	Dot []byte `protobuf:"bytes,1,opt,name=dot,proto3" json:"dot,omitempty"`
+	Svg []byte `protobuf:"bytes,2,opt,name=svg,proto3" json:"svg,omitempty"`
+}
+
+func (m *RenderResponse) Reset()         { *m = RenderResponse{} }
+func (m *RenderResponse) String() string { return proto.CompactTextString(m) }
+func (*RenderResponse) ProtoMessage()    {}
+
+func init() {
+	proto.RegisterType((*RenderRequest)(nil), "protodot.RenderRequest")
+	proto.RegisterType((*RenderResponse)(nil), "protodot.RenderResponse")
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// client
+
+type ProtodotClient interface {
+	Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error)
+}
+
+type protodotClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProtodotClient(cc *grpc.ClientConn) ProtodotClient {
+	return &protodotClient{cc}
+}
+
+func (c *protodotClient) Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error) {
+	out := new(RenderResponse)
+	if err := c.cc.Invoke(ctx, "/protodot.Protodot/Render", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// server
+
+type ProtodotServer interface {
+	Render(context.Context, *RenderRequest) (*RenderResponse, error)
+}
+
+func RegisterProtodotServer(s *grpc.Server, srv ProtodotServer) {
+	s.RegisterService(&protodotServiceDesc, srv)
+}
+
+func protodotRenderHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtodotServer).Render(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protodot.Protodot/Render",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtodotServer).Render(ctx, req.(*RenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var protodotServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protodot.Protodot",
+	HandlerType: (*ProtodotServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Render",
+			Handler:    protodotRenderHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protodot.proto",
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/seamia/protodot/core"
+	"sync"
+	"time"
+)
+
+type batchResult struct {
+	file string
+	err  error
+}
+
+// renders every file as a diagram of its own, 'jobs' files at a time. the files imported by many are parsed once
+func processBatch(s *core.Session, files []string, selection string, jobs int) error {
+	if jobs > 1 && len(s.Output) > 0 {
+		return errors.New("-output cannot be used with -j: every source gets a file of its own")
+	}
+	s.ShareParsedFiles()
+	started := time.Now()
+
+	pending := make(chan string)
+	results := make(chan batchResult)
+	var workers sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for file := range pending {
+				s.Trace(".\n.\n===================== processing: ", file, "=====================")
+				results <- batchResult{file: file, err: processOneProto(s, file, selection)}
+			}
+		}()
+	}
+	go func() {
+		for _, file := range files {
+			pending <- file
+		}
+		close(pending)
+		workers.Wait()
+		close(results)
+	}()
+
+	var failed []batchResult
+	for one := range results {
+		if one.err != nil {
+			s.Status("failed to process [", one.file, "]:", one.err)
+			failed = append(failed, one)
+		}
+	}
+
+	parsed, reused := s.ParsedFiles()
+	s.Status(fmt.Sprintf("processed %d file(s) in %v using %d worker(s): %d succeeded, %d failed (%d file(s) parsed, reused %d time(s))",
+		len(files), time.Since(started).Round(time.Millisecond), jobs, len(files)-len(failed), len(failed), parsed, reused))
+	for _, one := range failed {
+		s.Status("\t", one.file, ":", one.err)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to process %d out of %d file(s)", len(failed), len(files))
+	}
+	return nil
+}
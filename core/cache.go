@@ -0,0 +1,290 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/emicklei/proto"
+	"github.com/seamia/tools/support"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// parses the .proto source, naming it 'name'
+func parse(reader io.Reader, name string) (*proto.Proto, error) {
+	parser := proto.NewParser(reader)
+	parser.Filename(name)
+	definition, err := parser.Parse()
+	if err != nil {
+		return nil, &ParseError{Name: name, Err: err}
+	}
+	definition.Filename = name
+	return definition, nil
+}
+
+// the files parsed by (all the pbstates of) a session, keyed by their resolved location and the name they are known under
+type parseCache struct {
+	sync.Mutex
+	files  map[string]*proto.Proto
+	parsed int
+	reused int
+}
+
+// ShareParsedFiles makes the session parse every file once, no matter how many sources import it.
+// meant for the batches: the changes made to the files in the meantime are not noticed
+func (s *Session) ShareParsedFiles() {
+	s.parsed = &parseCache{files: make(map[string]*proto.Proto)}
+}
+
+// ParsedFiles tells how many files were parsed and how many times the parsed ones were reused (see ShareParsedFiles)
+func (s *Session) ParsedFiles() (parsed, reused int) {
+	if s.parsed == nil {
+		return 0, 0
+	}
+	s.parsed.Lock()
+	defer s.parsed.Unlock()
+	return s.parsed.parsed, s.parsed.reused
+}
+
+// the parsed file from the given location; 'name' is what the file is known under (see knownFiles).
+// the parsed files are not modified by the handlers, hence can be shared by the concurrent pbstates.
+// 'stored': the parsed file can be taken from (and is put into) the on-disk cache, see storedFiles
+func (s *Session) parseFile(location, name string, stored bool) (*proto.Proto, error) {
+	cache := s.parsed
+	if cache != nil {
+		cache.Lock()
+		definition, found := cache.files[name+"@"+location]
+		if found {
+			cache.reused++
+			cache.Unlock()
+			s.debug("-- reusing parsed file", location)
+			return definition, nil
+		}
+		cache.Unlock()
+	}
+
+	var definition *proto.Proto
+	var err error
+	found := false
+	if dir := s.storedFiles(); stored && len(dir) > 0 {
+		definition, found, err = s.parseStored(dir, location, name)
+	} else {
+		definition, err = parseLocation(location, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cache != nil {
+		cache.Lock()
+		cache.files[name+"@"+location] = definition
+		if found {
+			cache.reused++
+		} else {
+			cache.parsed++
+		}
+		cache.Unlock()
+	}
+	return definition, nil
+}
+
+func parseLocation(location, name string) (*proto.Proto, error) {
+	file, err := os.Open(location)
+	if err != nil {
+		return nil, &MissingFileError{Name: location, Err: err}
+	}
+	defer file.Close()
+	return parse(file, name)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// the on-disk cache of the parsed files: the files are parsed again only when their content changes.
+//
+//	parsed/<content key>         the parsed file (see codec.go), keyed by the hash of the content and the name
+//	parsed/index/<location key>  "<size> <modified> <content key>" of the file last seen at the location
+//
+// the file is read and hashed only when its size or modification time is not the one of the index;
+// the entries not used for storedMaxAge are removed (see pruneStored), so are the ones replaced by a newer content
+
+const (
+	storedParsedFiles = "cache parsed files" // option
+	storedVersion     = 2                    // change whenever the layout of the stored files changes
+	storedMaxAge      = 30 * 24 * time.Hour
+	storedTouchAge    = 24 * time.Hour // the used entries get their modification time updated (at most) this often
+)
+
+// the location of the stored parsed files, "" if they are not to be stored
+func (s *Session) storedFiles() string {
+	if !s.Option(storedParsedFiles) {
+		return ""
+	}
+	genDir, err := support.GetLocation(s.config, EntryGenerated)
+	if err != nil || len(genDir) == 0 {
+		return ""
+	}
+	return filepath.Join(os.ExpandEnv(genDir), "parsed")
+}
+
+// the parsed file depends on the content and on the name (see Position.Filename) only
+func storedKey(name string, data []byte) string {
+	return support.Hash(append([]byte(strconv.Itoa(storedVersion)+":"+name+":"), data...))
+}
+
+func indexKey(location, name string) string {
+	return support.Hash([]byte(strconv.Itoa(storedVersion) + ":" + name + "@" + location))
+}
+
+// what the index knows of the file at the location
+type storedIndex struct {
+	size     int64
+	modified int64 // UnixNano
+	key      string
+}
+
+func (index storedIndex) String() string {
+	return fmt.Sprintf("%d %d %s", index.size, index.modified, index.key)
+}
+
+func readIndex(path string) (storedIndex, bool) {
+	var index storedIndex
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return index, false
+	}
+	if _, err := fmt.Sscanf(string(data), "%d %d %s", &index.size, &index.modified, &index.key); err != nil {
+		return index, false
+	}
+	return index, true
+}
+
+// the parsed file from the location: the stored one, if the file has not changed since it was stored ('found')
+func (s *Session) parseStored(dir, location, name string) (*proto.Proto, bool, error) {
+	info, err := os.Stat(location)
+	if err != nil {
+		return nil, false, &MissingFileError{Name: location, Err: err}
+	}
+	indexPath := filepath.Join(dir, "index", indexKey(location, name))
+	index, indexed := readIndex(indexPath)
+	if indexed && index.size == info.Size() && index.modified == info.ModTime().UnixNano() {
+		if definition, found := s.loadStored(dir, index.key); found {
+			return definition, true, nil
+		}
+	}
+
+	data, err := ioutil.ReadFile(location)
+	if err != nil {
+		return nil, false, &MissingFileError{Name: location, Err: err}
+	}
+	key := storedKey(name, data)
+	definition, found := s.loadStored(dir, key)
+	if !found {
+		if definition, err = parse(bytes.NewReader(data), name); err != nil {
+			return nil, false, err
+		}
+		s.store(dir, key, definition)
+	}
+
+	if indexed && index.key != key {
+		// replaced by the current content
+		os.Remove(filepath.Join(dir, index.key))
+	}
+	current := storedIndex{size: info.Size(), modified: info.ModTime().UnixNano(), key: key}
+	if !indexed || index != current {
+		if err := writeStored(filepath.Dir(indexPath), filepath.Base(indexPath), []byte(current.String())); err != nil {
+			s.trace("failed to index the parsed file [", location, "]:", err)
+		}
+	}
+	return definition, found, nil
+}
+
+func (s *Session) loadStored(dir, key string) (*proto.Proto, bool) {
+	path := filepath.Join(dir, key)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	definition, err := decodeProto(data)
+	if err != nil {
+		s.trace("failed to load the stored parsed file [", key, "]:", err)
+		return nil, false
+	}
+	if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > storedTouchAge {
+		// still in use: not to be pruned
+		now := time.Now()
+		os.Chtimes(path, now, now)
+	}
+	s.debug("-- using stored parsed file", definition.Filename)
+	return definition, true
+}
+
+// failing to store is not an error: the file is parsed again next time
+func (s *Session) store(dir, key string, definition *proto.Proto) {
+	data, err := encodeProto(definition)
+	if err == nil {
+		err = writeStored(dir, key, data)
+	}
+	if err != nil {
+		s.trace("failed to store the parsed file [", definition.Filename, "]:", err)
+		return
+	}
+	s.pruned.Do(func() { s.pruneStored(dir) })
+}
+
+// the concurrent runs (see '-j') may be after the same file: never let them see a partial one
+func writeStored(dir, name string, data []byte) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	temp, err := ioutil.TempFile(dir, name+".*")
+	if err != nil {
+		return err
+	}
+	_, err = temp.Write(data)
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), filepath.Join(dir, name))
+	}
+	if err != nil {
+		os.Remove(temp.Name())
+	}
+	return err
+}
+
+// removes the stored files not used for storedMaxAge (and the index entries pointing at them).
+// done once per session, when something new gets stored: the cache does not grow otherwise
+func (s *Session) pruneStored(dir string) {
+	removed := 0
+	entries, _ := ioutil.ReadDir(dir)
+	for _, entry := range entries {
+		if entry.Mode().IsRegular() && time.Since(entry.ModTime()) > storedMaxAge {
+			if os.Remove(filepath.Join(dir, entry.Name())) == nil {
+				removed++
+			}
+		}
+	}
+
+	indexDir := filepath.Join(dir, "index")
+	entries, _ = ioutil.ReadDir(indexDir)
+	for _, entry := range entries {
+		path := filepath.Join(indexDir, entry.Name())
+		if index, found := readIndex(path); !found || !Exists(filepath.Join(dir, index.key)) {
+			if entry.Mode().IsRegular() && time.Since(entry.ModTime()) > storedTouchAge {
+				// (the recent ones may belong to a concurrent run still storing its file)
+				os.Remove(path)
+			}
+		}
+	}
+	if removed > 0 {
+		s.trace("removed", removed, "stale parsed file(s) from", dir)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"fmt"
+	"github.com/seamia/protodot/defaults"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// (almost) everything the parser produces
+const storedProto = `
+// the file comment
+syntax = "proto2";
+package co.stored;
+
+import "other.proto";
+import public "pub.proto";
+import weak "weak.proto";
+
+option java_package = "co.stored";
+option (co.opts.file) = { name: "x" nested { a: 1 b: [1, 2] } };
+
+/* the message */
+message Outer {
+	option (co.opts.msg) = true;
+
+	required string name = 1 [default = "none", (co.opts.rule).min = -1]; // inline
+	repeated Inner items = 2;
+	map<string, Inner> named = 3 [deprecated = true];
+	optional group Result = 4 {
+		optional string url = 5;
+	}
+	oneof choice {
+		option (co.opts.oneof) = 1;
+		string text = 6 [(co.opts.rule) = "x"];
+		Inner inner = 7;
+	}
+	extensions 100 to 199, 1000 to max;
+	reserved 8, 15, 9 to 11;
+	reserved "foo", "bar";
+
+	message Inner {
+		enum Kind {
+			option allow_alias = true;
+			ZERO = 0;
+			ONE = 1 [(co.opts.value) = 1, deprecated = true];
+			MINUS = -1;
+		}
+		optional Kind kind = 1;
+	}
+
+	extend Other {
+		optional string nested_ext = 100;
+	}
+}
+
+extend Other {
+	optional int32 ext = 101;
+}
+
+service Api {
+	option (co.opts.api) = "api";
+	rpc Get(Outer) returns (stream Outer) {
+		option (co.opts.http) = { get: "/v1/{name}" additional_bindings { post: "/v1" } };
+	}
+	rpc Put(stream Outer) returns (Outer); // inline
+}
+`
+
+func newStoredSession(t testing.TB) (*Session, string) {
+	dir, err := ioutil.TempDir("", "protodot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := defaults.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config["locations"].(map[string]interface{})[EntryGenerated] = dir
+	s, err := NewSession(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SuppressOutput()
+	return s, dir
+}
+
+func TestStoredEncoding(t *testing.T) {
+	parsed, err := parse(strings.NewReader(storedProto), "stored.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := encodeProto(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, decoded) {
+		t.Errorf("the decoded file differs from the parsed one")
+	}
+
+	for _, size := range []int{0, 10, len(data) / 2, len(data) - 1} {
+		if _, err := decodeProto(data[:size]); err == nil {
+			t.Errorf("no error for the data truncated to %d bytes", size)
+		}
+	}
+}
+
+func TestStoredFiles(t *testing.T) {
+	s, dir := newStoredSession(t)
+	defer os.RemoveAll(dir)
+	stored := s.storedFiles()
+	location := filepath.Join(dir, "stored.proto")
+
+	step := func(what string, wantFound bool) {
+		t.Helper()
+		definition, found, err := s.parseStored(stored, location, "stored.proto")
+		if err != nil {
+			t.Fatalf("%s: %v", what, err)
+		}
+		if found != wantFound {
+			t.Errorf("%s: found = %v; want %v", what, found, wantFound)
+		}
+		if definition == nil || definition.Filename != "stored.proto" {
+			t.Errorf("%s: unexpected parsed file %v", what, definition)
+		}
+	}
+	entries := func() []string {
+		var names []string
+		infos, _ := ioutil.ReadDir(stored)
+		for _, info := range infos {
+			if info.Mode().IsRegular() {
+				names = append(names, info.Name())
+			}
+		}
+		return names
+	}
+
+	if err := ioutil.WriteFile(location, []byte(storedProto), 0644); err != nil {
+		t.Fatal(err)
+	}
+	step("first run", false)
+	step("second run", true)
+
+	// the same content, but not the same modification time: found by the hash
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(location, later, later); err != nil {
+		t.Fatal(err)
+	}
+	step("touched", true)
+	first := entries()
+	if len(first) != 1 {
+		t.Fatalf("stored files: %v", first)
+	}
+
+	// the changed file replaces the stored one
+	if err := ioutil.WriteFile(location, []byte(storedProto+"\nmessage Added {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	step("changed", false)
+	if second := entries(); len(second) != 1 || second[0] == first[0] {
+		t.Errorf("stored files after the change: %v (was %v)", second, first)
+	}
+
+	// the broken stored file is parsed again
+	if err := ioutil.WriteFile(filepath.Join(stored, entries()[0]), []byte("garbage"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	step("broken", false)
+	step("repaired", true)
+
+	// the stale files are removed (and so are the index entries pointing at them)
+	stale := time.Now().Add(-2 * storedMaxAge)
+	for _, name := range entries() {
+		os.Chtimes(filepath.Join(stored, name), stale, stale)
+	}
+	indexes, _ := ioutil.ReadDir(filepath.Join(stored, "index"))
+	for _, index := range indexes {
+		os.Chtimes(filepath.Join(stored, "index", index.Name()), stale, stale)
+	}
+	s.pruneStored(stored)
+	if left := entries(); len(left) > 0 {
+		t.Errorf("stale stored files left: %v", left)
+	}
+	if indexes, _ := ioutil.ReadDir(filepath.Join(stored, "index")); len(indexes) > 0 {
+		t.Errorf("stale index entries left: %d", len(indexes))
+	}
+	step("pruned", false)
+}
+
+// a file of the given number of (not too trivial) messages
+func storedBenchmarkProto(messages int) string {
+	var text strings.Builder
+	text.WriteString("syntax = \"proto3\";\npackage co.bench;\nimport \"google/protobuf/timestamp.proto\";\n")
+	for index := 0; index < messages; index++ {
+		fmt.Fprintf(&text, "// message number %d\nmessage M%d {\n", index, index)
+		fmt.Fprintf(&text, "\tstring name = 1 [(co.opts.rule) = { min: 1 max: 10 }]; // the name\n")
+		fmt.Fprintf(&text, "\trepeated M%d next = 2;\n\tmap<string, int64> counts = 3;\n", index+1)
+		fmt.Fprintf(&text, "\toneof value {\n\t\tint32 number = 4;\n\t\tgoogle.protobuf.Timestamp time = 5;\n\t}\n")
+		fmt.Fprintf(&text, "\tenum Kind {\n\t\tNONE = 0;\n\t\tSOME = 1 [deprecated = true];\n\t}\n\tKind kind = 6;\n}\n")
+	}
+	fmt.Fprintf(&text, "message M%d {}\n", messages)
+	return text.String()
+}
+
+// how long it takes to get a parsed (unchanged) file: parsing it vs. taking the stored one
+func BenchmarkParsedFiles(b *testing.B) {
+	s, dir := newStoredSession(b)
+	defer os.RemoveAll(dir)
+	location := filepath.Join(dir, "bench.proto")
+	if err := ioutil.WriteFile(location, []byte(storedBenchmarkProto(200)), 0644); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := s.parseFile(location, "bench.proto", true); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("parsed", func(b *testing.B) {
+		for index := 0; index < b.N; index++ {
+			if _, err := s.parseFile(location, "bench.proto", false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("stored", func(b *testing.B) {
+		for index := 0; index < b.N; index++ {
+			if _, found, err := s.parseStored(s.storedFiles(), location, "bench.proto"); err != nil || !found {
+				b.Fatal("not stored:", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,575 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/emicklei/proto"
+	"text/scanner"
+)
+
+//----------------------------------------------------------------------------------------------------------------------
+// the compact binary form of the parsed .proto files, see the stored files (cache.go).
+// written by hand: the parents are set while decoding, the way the parser does, and nothing is looked up by reflection
+
+const codecMagic = "protodot/parsed"
+
+// the tags of the elements
+const (
+	tagComment byte = 1 + iota
+	tagEnum
+	tagEnumField
+	tagExtensions
+	tagGroup
+	tagImport
+	tagMapField
+	tagMessage
+	tagNormalField
+	tagOneOfField
+	tagOneof
+	tagOption
+	tagPackage
+	tagRPC
+	tagReserved
+	tagService
+	tagSyntax
+)
+
+// the file names of the positions
+const (
+	positionUnnamed byte = iota
+	positionInFile
+	positionNamed
+)
+
+type encoder struct {
+	data     []byte
+	filename string
+	scratch  [binary.MaxVarintLen64]byte
+}
+
+func encodeProto(definition *proto.Proto) ([]byte, error) {
+	e := encoder{filename: definition.Filename}
+	e.str(codecMagic)
+	e.uint(storedVersion)
+	e.str(definition.Filename)
+	if err := e.elements(definition.Elements); err != nil {
+		return nil, err
+	}
+	return e.data, nil
+}
+
+func (e *encoder) uint(value uint64) {
+	n := binary.PutUvarint(e.scratch[:], value)
+	e.data = append(e.data, e.scratch[:n]...)
+}
+
+func (e *encoder) int(value int) {
+	n := binary.PutVarint(e.scratch[:], int64(value))
+	e.data = append(e.data, e.scratch[:n]...)
+}
+
+func (e *encoder) bool(value bool) {
+	if value {
+		e.data = append(e.data, 1)
+	} else {
+		e.data = append(e.data, 0)
+	}
+}
+
+func (e *encoder) str(value string) {
+	e.uint(uint64(len(value)))
+	e.data = append(e.data, value...)
+}
+
+func (e *encoder) strs(values []string) {
+	e.uint(uint64(len(values)))
+	for _, one := range values {
+		e.str(one)
+	}
+}
+
+// the file name is (almost) the same for all the positions of a file: it is not repeated
+func (e *encoder) position(position scanner.Position) {
+	switch position.Filename {
+	case "":
+		e.data = append(e.data, positionUnnamed)
+	case e.filename:
+		e.data = append(e.data, positionInFile)
+	default:
+		e.data = append(e.data, positionNamed)
+		e.str(position.Filename)
+	}
+	e.int(position.Offset)
+	e.int(position.Line)
+	e.int(position.Column)
+}
+
+func (e *encoder) comment(comment *proto.Comment) {
+	e.bool(comment != nil)
+	if comment != nil {
+		e.position(comment.Position)
+		e.strs(comment.Lines)
+		e.bool(comment.Cstyle)
+		e.bool(comment.ExtraSlash)
+	}
+}
+
+func (e *encoder) ranges(ranges []proto.Range) {
+	e.uint(uint64(len(ranges)))
+	for _, one := range ranges {
+		e.int(one.From)
+		e.int(one.To)
+		e.bool(one.Max)
+	}
+}
+
+func (e *encoder) literal(literal *proto.Literal) {
+	e.position(literal.Position)
+	e.str(literal.Source)
+	e.bool(literal.IsString)
+	e.uint(uint64(len(literal.Array)))
+	for _, one := range literal.Array {
+		e.literal(one)
+	}
+	e.namedLiterals(literal.OrderedMap)
+	e.bool(literal.Map != nil) // the (deprecated) Map holds the same literals as OrderedMap does
+}
+
+func (e *encoder) namedLiterals(literals []*proto.NamedLiteral) {
+	e.uint(uint64(len(literals)))
+	for _, one := range literals {
+		e.str(one.Name)
+		e.bool(one.PrintsColon)
+		e.literal(one.Literal)
+	}
+}
+
+func (e *encoder) option(option *proto.Option) {
+	e.position(option.Position)
+	e.comment(option.Comment)
+	e.str(option.Name)
+	e.literal(&option.Constant)
+	e.bool(option.IsEmbedded)
+	e.namedLiterals(option.AggregatedConstants)
+	e.comment(option.InlineComment)
+}
+
+func (e *encoder) options(options []*proto.Option) {
+	e.uint(uint64(len(options)))
+	for _, one := range options {
+		e.option(one)
+	}
+}
+
+func (e *encoder) field(field *proto.Field) {
+	e.position(field.Position)
+	e.comment(field.Comment)
+	e.str(field.Name)
+	e.str(field.Type)
+	e.int(field.Sequence)
+	e.options(field.Options)
+	e.comment(field.InlineComment)
+}
+
+func (e *encoder) elements(elements []proto.Visitee) error {
+	e.uint(uint64(len(elements)))
+	for _, element := range elements {
+		if err := e.element(element); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *encoder) element(element proto.Visitee) error {
+	switch actual := element.(type) {
+	case *proto.Comment:
+		e.data = append(e.data, tagComment)
+		e.comment(actual)
+	case *proto.Enum:
+		e.data = append(e.data, tagEnum)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		return e.elements(actual.Elements)
+	case *proto.EnumField:
+		e.data = append(e.data, tagEnumField)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		e.int(actual.Integer)
+		e.comment(actual.InlineComment)
+		return e.elements(actual.Elements)
+	case *proto.Extensions:
+		e.data = append(e.data, tagExtensions)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.ranges(actual.Ranges)
+		e.comment(actual.InlineComment)
+	case *proto.Group:
+		e.data = append(e.data, tagGroup)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		e.bool(actual.Optional)
+		e.bool(actual.Repeated)
+		e.bool(actual.Required)
+		e.int(actual.Sequence)
+		return e.elements(actual.Elements)
+	case *proto.Import:
+		e.data = append(e.data, tagImport)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Filename)
+		e.str(actual.Kind)
+		e.comment(actual.InlineComment)
+	case *proto.MapField:
+		e.data = append(e.data, tagMapField)
+		e.field(actual.Field)
+		e.str(actual.KeyType)
+	case *proto.Message:
+		e.data = append(e.data, tagMessage)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		e.bool(actual.IsExtend)
+		return e.elements(actual.Elements)
+	case *proto.NormalField:
+		e.data = append(e.data, tagNormalField)
+		e.field(actual.Field)
+		e.bool(actual.Repeated)
+		e.bool(actual.Optional)
+		e.bool(actual.Required)
+	case *proto.OneOfField:
+		e.data = append(e.data, tagOneOfField)
+		e.field(actual.Field)
+	case *proto.Oneof:
+		e.data = append(e.data, tagOneof)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		return e.elements(actual.Elements)
+	case *proto.Option:
+		e.data = append(e.data, tagOption)
+		e.option(actual)
+	case *proto.Package:
+		e.data = append(e.data, tagPackage)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		e.comment(actual.InlineComment)
+	case *proto.RPC:
+		e.data = append(e.data, tagRPC)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		e.str(actual.RequestType)
+		e.bool(actual.StreamsRequest)
+		e.str(actual.ReturnsType)
+		e.bool(actual.StreamsReturns)
+		e.comment(actual.InlineComment)
+		return e.elements(actual.Elements)
+	case *proto.Reserved:
+		e.data = append(e.data, tagReserved)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.ranges(actual.Ranges)
+		e.strs(actual.FieldNames)
+		e.comment(actual.InlineComment)
+	case *proto.Service:
+		e.data = append(e.data, tagService)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Name)
+		return e.elements(actual.Elements)
+	case *proto.Syntax:
+		e.data = append(e.data, tagSyntax)
+		e.position(actual.Position)
+		e.comment(actual.Comment)
+		e.str(actual.Value)
+		e.comment(actual.InlineComment)
+	default:
+		return fmt.Errorf("storing %T is not supported", element)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var errTruncated = errors.New("truncated data")
+
+// the first failure sticks: the values read after it are all zeros.
+// the strings are the slices of the (single copy of) data: no allocation per string
+type decoder struct {
+	data     string
+	filename string
+	err      error
+}
+
+func decodeProto(data []byte) (*proto.Proto, error) {
+	d := decoder{data: string(data)}
+	if d.str() != codecMagic || d.uint() != storedVersion {
+		return nil, errors.New("not a stored parsed file (or of another version)")
+	}
+	definition := proto.Proto{Filename: d.str()}
+	d.filename = definition.Filename
+	definition.Elements = d.elements(&definition)
+	if d.err == nil && len(d.data) > 0 {
+		d.err = errors.New("unexpected data at the end")
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &definition, nil
+}
+
+func (d *decoder) uint() uint64 {
+	var value uint64
+	for index := 0; index < len(d.data) && index < binary.MaxVarintLen64; index++ {
+		b := d.data[index]
+		value |= uint64(b&0x7f) << (7 * uint(index))
+		if b < 0x80 {
+			d.data = d.data[index+1:]
+			return value
+		}
+	}
+	d.fail(errTruncated)
+	return 0
+}
+
+// see binary.PutVarint
+func (d *decoder) int() int {
+	value := d.uint()
+	if value&1 != 0 {
+		return int(^(value >> 1))
+	}
+	return int(value >> 1)
+}
+
+// the number of the items to follow: each of them takes a byte at least
+func (d *decoder) count() int {
+	count := d.uint()
+	if count > uint64(len(d.data)) {
+		d.fail(errTruncated)
+		return 0
+	}
+	return int(count)
+}
+
+func (d *decoder) byte() byte {
+	if len(d.data) == 0 {
+		d.fail(errTruncated)
+		return 0
+	}
+	value := d.data[0]
+	d.data = d.data[1:]
+	return value
+}
+
+func (d *decoder) bool() bool {
+	return d.byte() != 0
+}
+
+func (d *decoder) str() string {
+	size := d.count()
+	value := d.data[:size]
+	d.data = d.data[size:]
+	return value
+}
+
+func (d *decoder) strs() []string {
+	count := d.count()
+	if count == 0 {
+		return nil
+	}
+	values := make([]string, count)
+	for index := range values {
+		values[index] = d.str()
+	}
+	return values
+}
+
+func (d *decoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+		d.data = ""
+	}
+}
+
+func (d *decoder) position() scanner.Position {
+	position := scanner.Position{}
+	switch d.byte() {
+	case positionInFile:
+		position.Filename = d.filename
+	case positionNamed:
+		position.Filename = d.str()
+	}
+	position.Offset, position.Line, position.Column = d.int(), d.int(), d.int()
+	return position
+}
+
+func (d *decoder) comment() *proto.Comment {
+	if !d.bool() {
+		return nil
+	}
+	return &proto.Comment{Position: d.position(), Lines: d.strs(), Cstyle: d.bool(), ExtraSlash: d.bool()}
+}
+
+func (d *decoder) ranges() []proto.Range {
+	count := d.count()
+	if count == 0 {
+		return nil
+	}
+	ranges := make([]proto.Range, count)
+	for index := range ranges {
+		ranges[index] = proto.Range{From: d.int(), To: d.int(), Max: d.bool()}
+	}
+	return ranges
+}
+
+func (d *decoder) literal() *proto.Literal {
+	literal := proto.Literal{}
+	d.literalInto(&literal)
+	return &literal
+}
+
+func (d *decoder) literalInto(literal *proto.Literal) {
+	literal.Position, literal.Source, literal.IsString = d.position(), d.str(), d.bool()
+	if count := d.count(); count > 0 {
+		literal.Array = make([]*proto.Literal, count)
+		for index := range literal.Array {
+			literal.Array[index] = d.literal()
+		}
+	}
+	literal.OrderedMap = proto.LiteralMap(d.namedLiterals())
+	if d.bool() {
+		literal.Map = make(map[string]*proto.Literal)
+		for _, one := range literal.OrderedMap {
+			literal.Map[one.Name] = one.Literal
+		}
+	}
+}
+
+func (d *decoder) namedLiterals() []*proto.NamedLiteral {
+	count := d.count()
+	if count == 0 {
+		return nil
+	}
+	literals := make([]*proto.NamedLiteral, count)
+	for index := range literals {
+		literals[index] = &proto.NamedLiteral{Name: d.str(), PrintsColon: d.bool(), Literal: d.literal()}
+	}
+	return literals
+}
+
+func (d *decoder) option(parent proto.Visitee) *proto.Option {
+	option := proto.Option{Position: d.position(), Comment: d.comment(), Name: d.str()}
+	d.literalInto(&option.Constant)
+	option.IsEmbedded = d.bool()
+	option.AggregatedConstants = d.namedLiterals()
+	option.InlineComment = d.comment()
+	option.Parent = parent
+	return &option
+}
+
+// the options of the fields are not their elements: the parser leaves them without a parent
+func (d *decoder) field(parent proto.Visitee) *proto.Field {
+	field := proto.Field{Position: d.position(), Comment: d.comment(), Name: d.str(), Type: d.str(), Sequence: d.int()}
+	if count := d.count(); count > 0 {
+		field.Options = make([]*proto.Option, count)
+		for index := range field.Options {
+			field.Options[index] = d.option(nil)
+		}
+	}
+	field.InlineComment = d.comment()
+	field.Parent = parent
+	return &field
+}
+
+func (d *decoder) elements(parent proto.Visitee) []proto.Visitee {
+	count := d.count()
+	if count == 0 {
+		return nil
+	}
+	elements := make([]proto.Visitee, 0, count)
+	for index := 0; index < count && d.err == nil; index++ {
+		elements = append(elements, d.element(parent))
+	}
+	return elements
+}
+
+func (d *decoder) element(parent proto.Visitee) proto.Visitee {
+	switch tag := d.byte(); tag {
+	case tagComment:
+		comment := d.comment()
+		if comment == nil {
+			d.fail(errors.New("empty comment"))
+			return &proto.Comment{}
+		}
+		return comment
+	case tagEnum:
+		enum := &proto.Enum{Position: d.position(), Comment: d.comment(), Name: d.str(), Parent: parent}
+		enum.Elements = d.elements(enum)
+		return enum
+	case tagEnumField:
+		field := &proto.EnumField{Position: d.position(), Comment: d.comment(), Name: d.str(), Integer: d.int(), InlineComment: d.comment(), Parent: parent}
+		field.Elements = d.elements(field)
+		for _, element := range field.Elements {
+			if option, ok := element.(*proto.Option); ok {
+				field.ValueOption = option // the last one, as the parser does
+			}
+		}
+		return field
+	case tagExtensions:
+		return &proto.Extensions{Position: d.position(), Comment: d.comment(), Ranges: d.ranges(), InlineComment: d.comment(), Parent: parent}
+	case tagGroup:
+		group := &proto.Group{Position: d.position(), Comment: d.comment(), Name: d.str(), Optional: d.bool(), Repeated: d.bool(), Required: d.bool(), Sequence: d.int(), Parent: parent}
+		group.Elements = d.elements(group)
+		return group
+	case tagImport:
+		return &proto.Import{Position: d.position(), Comment: d.comment(), Filename: d.str(), Kind: d.str(), InlineComment: d.comment(), Parent: parent}
+	case tagMapField:
+		return &proto.MapField{Field: d.field(parent), KeyType: d.str()}
+	case tagMessage:
+		message := &proto.Message{Position: d.position(), Comment: d.comment(), Name: d.str(), IsExtend: d.bool(), Parent: parent}
+		message.Elements = d.elements(message)
+		return message
+	case tagNormalField:
+		return &proto.NormalField{Field: d.field(parent), Repeated: d.bool(), Optional: d.bool(), Required: d.bool()}
+	case tagOneOfField:
+		return &proto.OneOfField{Field: d.field(parent)}
+	case tagOneof:
+		oneof := &proto.Oneof{Position: d.position(), Comment: d.comment(), Name: d.str(), Parent: parent}
+		oneof.Elements = d.elements(oneof)
+		return oneof
+	case tagOption:
+		return d.option(parent)
+	case tagPackage:
+		return &proto.Package{Position: d.position(), Comment: d.comment(), Name: d.str(), InlineComment: d.comment(), Parent: parent}
+	case tagRPC:
+		rpc := &proto.RPC{Position: d.position(), Comment: d.comment(), Name: d.str(), RequestType: d.str(), StreamsRequest: d.bool(), ReturnsType: d.str(), StreamsReturns: d.bool(), InlineComment: d.comment(), Parent: parent}
+		rpc.Elements = d.elements(rpc)
+		for _, element := range rpc.Elements {
+			if option, ok := element.(*proto.Option); ok {
+				rpc.Options = append(rpc.Options, option) // the deprecated copy, as the parser makes it
+			}
+		}
+		return rpc
+	case tagReserved:
+		return &proto.Reserved{Position: d.position(), Comment: d.comment(), Ranges: d.ranges(), FieldNames: d.strs(), InlineComment: d.comment(), Parent: parent}
+	case tagService:
+		service := &proto.Service{Position: d.position(), Comment: d.comment(), Name: d.str(), Parent: parent}
+		service.Elements = d.elements(service)
+		return service
+	case tagSyntax:
+		return &proto.Syntax{Position: d.position(), Comment: d.comment(), Value: d.str(), InlineComment: d.comment(), Parent: parent}
+	default:
+		d.fail(fmt.Errorf("unknown element [%d]", tag))
+		return &proto.Comment{}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// the number of the types 'full' depends on (directly or not, see pbstate.references) that are not among the shown ones.
+// the excluded types are not counted: they would not be shown anyway
+func (pbs *pbstate) hiddenDependencies(full FullName, shown map[FullName]tinfo, references map[FullName][]FullName) int {
+	seen := map[FullName]bool{full: true}
+	pending := []FullName{full}
+	hidden := 0
+	for len(pending) > 0 {
+		next := pending[0]
+		pending = pending[1:]
+		for _, one := range references[next] {
+			if seen[one] || pbs.isExcluded(one) {
+				continue
+			}
+			seen[one] = true
+			if _, found := shown[one]; !found {
+				hidden++
+			}
+			pending = append(pending, one)
+		}
+	}
+	return hidden
+}
+
+// the placeholder, shown instead of the type (and its dependencies) beyond the '-depth'
+func (pbs *pbstate) collapse(info tinfo, hidden int) tinfo {
+	payload := CollapsedNode{
+		Name:     info.name,
+		Unique:   info.unique,
+		FullName: info.fullname,
+		Hidden:   hidden,
+	}
+	writer := bytes.NewBufferString("")
+	if err := pbs.templates().ApplyTemplate("collapsed.node", writer, payload); err != nil {
+		pbs.fail(&RenderError{Template: "collapsed.node", Err: err})
+	}
+
+	info.raw = writer.String()
+	info.rows = []row{{cells: []string{"+" + strconv.Itoa(hidden) + " hidden"}, color: "collapsed.background", span: true}}
+	info.hidden = hidden
+	info.object = nil
+	return info
+}
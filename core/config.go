@@ -0,0 +1,131 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"fmt"
+	"github.com/seamia/protodot/plus"
+	"github.com/seamia/tools/support"
+	"os"
+	"strings"
+	"sync"
+)
+
+// everything a single run of the pipeline needs: independent sessions do not share any state
+type Session struct {
+	config    map[string]interface{}
+	includes  []string
+	templates *plus.Templates
+	sets      map[string]*plus.Templates // additional (named) template sets, see 'templates.<format>' config sections
+	Output    string                     // name of the output file (overwrites the generated one)
+	Format    string                     // format of the output file: "dot" (default), "mermaid", "json" or the name of a template set, e.g. "plantuml"
+	Report    string                     // report to produce along with the output: "unused" or none
+	Depth     int                        // how far from the selected types their dependencies are shown, 0: no limit
+	Exclude   string                     // (semicolon separated) types not to show, in addition to the 'exclude' list of the config
+	exclude   []string
+	parsed    *parseCache // see ShareParsedFiles
+	pruned    sync.Once   // see pruneStored
+	log       *logger     // see SetLogWriter, SuppressOutput
+}
+
+// includes: (semicolon separated) list of the include directories, in addition to the ones in the config
+func NewSession(config map[string]interface{}, includes string) (*Session, error) {
+	s := Session{
+		config:   config,
+		includes: make([]string, 0),
+		log:      newLogger(),
+	}
+	if s.Option("suppress all output") {
+		s.SuppressOutput()
+	}
+
+	// 1. get the includes from the config file
+	if list, found := config["includes"].([]interface{}); found {
+		for _, include := range list {
+			candidate := os.ExpandEnv(include.(string))
+			if len(candidate) > 0 {
+				s.includes = append(s.includes, candidate)
+			}
+		}
+	}
+
+	// 2. get the includes from the caller
+	for _, part := range strings.Split(includes, ";") {
+		candidate := os.ExpandEnv(part)
+		if len(candidate) > 0 {
+			s.includes = append(s.includes, candidate)
+		}
+	}
+
+	// 3. get the types to exclude (see Session.Exclude)
+	if list, found := config["exclude"].([]interface{}); found {
+		for _, one := range list {
+			if term, ok := one.(string); ok && len(term) > 0 {
+				s.exclude = append(s.exclude, term)
+			}
+		}
+	}
+
+	// 4. preload templates
+	tmpls, _ := config["templates"].(map[string]interface{})
+	if len(tmpls) == 0 {
+		return nil, &NoTemplatesError{}
+	}
+	tmplDir, err := support.GetLocation(config, "templates")
+	if err != nil {
+		tmplDir = ""
+	}
+
+	s.templates, err = plus.PreloadTemplates(tmpls, s.templateFuncs(), tmplDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. preload the named template sets
+	s.sets = make(map[string]*plus.Templates)
+	for key, value := range config {
+		if strings.HasPrefix(key, templateSetPrefix) {
+			tmpls, _ := value.(map[string]interface{})
+			if s.sets[key[len(templateSetPrefix):]], err = plus.PreloadTemplates(tmpls, s.templateFuncs(), tmplDir); err != nil {
+				return nil, fmt.Errorf("failed to load [%s]: %v", key, err)
+			}
+		}
+	}
+	return &s, nil
+}
+
+const templateSetPrefix = "templates."
+
+// the templates to produce the given format with; the default ones (i.e. "dot") if there is no dedicated set
+func (s *Session) templatesFor(format string) *plus.Templates {
+	if set, found := s.sets[format]; found {
+		return set
+	}
+	return s.templates
+}
+
+// SupportedFormat tells if the given format can be produced by Process (see Session.Format)
+func (s *Session) SupportedFormat(format string) bool {
+	if _, found := format2extension[format]; found {
+		return true
+	}
+	_, found := s.sets[format]
+	return found
+}
+
+func (s *Session) Option(name string) bool {
+	if s != nil && s.config != nil && len(name) > 0 {
+		if copts, found := s.config["options"]; found {
+			opts, found := copts.(map[string]interface{})
+			if found && len(opts) > 0 {
+				if value, found := opts[name]; found {
+					return value.(bool)
+				}
+			}
+		}
+	}
+	s.trace("Option [" + name + "] was not found - returning the default: false")
+	return false
+}
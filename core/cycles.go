@@ -0,0 +1,77 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"sort"
+	"strings"
+)
+
+// the import cycles among the known files, each one as a list of the files, starting and ending with the same one
+func (pbs *pbstate) importCycles() [][]string {
+	names := make([]string, 0, len(pbs.knownFiles))
+	for name := range pbs.knownFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := make(map[string]int)
+	var stack []string
+	var cycles [][]string
+
+	// every edge leading back into the active part of the path closes a cycle
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = active
+		stack = append(stack, name)
+		if info, found := pbs.knownFiles[name]; found {
+			for _, dependency := range info.dependencies {
+				switch state[dependency] {
+				case unvisited:
+					visit(dependency)
+				case active:
+					for index := len(stack) - 1; index >= 0; index-- {
+						if stack[index] == dependency {
+							cycle := append([]string{}, stack[index:]...)
+							cycles = append(cycles, append(cycle, dependency))
+							break
+						}
+					}
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = done
+	}
+
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+	return cycles
+}
+
+// "from>to" of every import that is a part of a cycle
+func cycleEdges(cycles [][]string) map[string]bool {
+	edges := make(map[string]bool)
+	for _, cycle := range cycles {
+		for index := 1; index < len(cycle); index++ {
+			edges[cycle[index-1]+">"+cycle[index]] = true
+		}
+	}
+	return edges
+}
+
+func (pbs *pbstate) showCycles(cycles [][]string) {
+	for _, cycle := range cycles {
+		pbs.status("import cycle:", strings.Join(cycle, " -> "))
+	}
+}
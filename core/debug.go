@@ -0,0 +1,136 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"fmt"
+	"io"
+)
+
+const debugTrace = 2
+const debugDebug = 4
+const debugAlert = 8
+const debugStatus = 16
+
+const debugALL = debugStatus + debugAlert + debugDebug + debugTrace
+const debugNone = 0
+const debugNormal = debugStatus
+
+// where the messages of a session go: every session has its own (see Session.SetLogWriter, Session.SuppressOutput)
+type logger struct {
+	writer io.Writer // a copy of the alerts and the statuses, if set
+	level  int
+}
+
+func newLogger() *logger {
+	return &logger{level: debugNormal}
+}
+
+// the nil logger (e.g. of a pbstate without a session) stays silent
+func (l *logger) enabled(level int) bool {
+	return l != nil && (l.level&level) == level
+}
+
+func (l *logger) alert(a ...interface{}) {
+	if l.enabled(debugAlert) {
+		fmt.Println("ALERT!!!!", a)
+		if l.writer != nil {
+			fmt.Fprintln(l.writer, a...)
+		}
+	}
+}
+
+func (l *logger) trace(a ...interface{}) {
+	if l.enabled(debugTrace) {
+		fmt.Println(a...)
+		if l.writer != nil {
+			// fmt.Fprintln(l.writer, a...)
+		}
+	}
+}
+
+func (l *logger) debug(a ...interface{}) {
+	if l.enabled(debugDebug) {
+		fmt.Println(a...)
+
+		if l.writer != nil {
+			// fmt.Fprintln(l.writer, a...)
+		}
+	}
+}
+
+func (l *logger) status(a ...interface{}) {
+	if l.enabled(debugStatus) {
+		fmt.Println(a...)
+		if l.writer != nil {
+			fmt.Fprintln(l.writer, a...)
+		}
+	}
+}
+
+func (s *Session) logger() *logger {
+	if s == nil {
+		return nil
+	}
+	return s.log
+}
+
+func (s *Session) alert(a ...interface{})  { s.logger().alert(a...) }
+func (s *Session) trace(a ...interface{})  { s.logger().trace(a...) }
+func (s *Session) debug(a ...interface{})  { s.logger().debug(a...) }
+func (s *Session) status(a ...interface{}) { s.logger().status(a...) }
+
+func (pbs *pbstate) alert(a ...interface{})  { pbs.session.alert(a...) }
+func (pbs *pbstate) trace(a ...interface{})  { pbs.session.trace(a...) }
+func (pbs *pbstate) debug(a ...interface{})  { pbs.session.debug(a...) }
+func (pbs *pbstate) status(a ...interface{}) { pbs.session.status(a...) }
+
+func ignoring(a ...interface{}) {
+	// debug(a...)
+}
+
+func unhandled(a ...interface{}) {
+	// debug(a...)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// exported for the front-ends (command line, daemons)
+
+// SetLogWriter makes the session copy its alerts and statuses into the given writer
+func (s *Session) SetLogWriter(writer io.Writer) {
+	s.log.writer = writer
+}
+
+// SuppressOutput silences the session (the 'suppress all output' option does the same)
+func (s *Session) SuppressOutput() {
+	s.log.level = debugNone
+}
+
+func (s *Session) Status(a ...interface{}) {
+	s.status(a...)
+}
+
+func (s *Session) Trace(a ...interface{}) {
+	s.trace(a...)
+}
+
+func (s *Session) Alert(a ...interface{}) {
+	s.alert(a...)
+}
+
+// the messages of the front-ends not (yet) having a session
+var defaultLog = newLogger()
+
+func Status(a ...interface{}) {
+	defaultLog.status(a...)
+}
+
+func Trace(a ...interface{}) {
+	defaultLog.trace(a...)
+}
+
+func Alert(a ...interface{}) {
+	defaultLog.alert(a...)
+}
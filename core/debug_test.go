@@ -0,0 +1,49 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"bytes"
+	"github.com/seamia/protodot/defaults"
+	"testing"
+)
+
+func TestSessionLogging(t *testing.T) {
+	config, err := defaults.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loud, err := NewSession(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewSession(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config["options"].(map[string]interface{})["suppress all output"] = true
+	quiet, err := NewSession(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var loudLog, quietLog, otherLog bytes.Buffer
+	loud.SetLogWriter(&loudLog)
+	quiet.SetLogWriter(&quietLog)
+	other.SetLogWriter(&otherLog)
+	other.SuppressOutput()
+
+	loud.Status("from loud")
+	quiet.Status("from quiet")
+	other.Alert("from other")
+	NewPbs(loud).status("from pbs")
+
+	if got := loudLog.String(); got != "from loud\nfrom pbs\n" {
+		t.Errorf("loud session logged %q", got)
+	}
+	if quietLog.Len() > 0 || otherLog.Len() > 0 {
+		t.Errorf("suppressed sessions logged %q and %q", quietLog.String(), otherLog.String())
+	}
+}
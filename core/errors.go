@@ -0,0 +1,117 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MissingFileError is reported when the root .proto (or a required import) cannot be found/opened
+type MissingFileError struct {
+	Name string
+	Err  error
+}
+
+func (e *MissingFileError) Error() string {
+	return "failed to open [" + e.Name + "], with error: " + e.Err.Error()
+}
+
+// ParseError is reported when a .proto file cannot be parsed
+type ParseError struct {
+	Name string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return "failed to parse [" + e.Name + "], with error: " + e.Err.Error()
+}
+
+// UnresolvedTypeError is reported when a type, used in Scope, is not declared anywhere
+type UnresolvedTypeError struct {
+	Type  OriginalName
+	Scope FullName
+}
+
+func (e *UnresolvedTypeError) Error() string {
+	return "unresolved type [" + string(e.Type) + "], used in " + string(e.Scope)
+}
+
+// SelectionError is reported when nothing matches (a part of) the selection
+type SelectionError struct {
+	Selection string
+}
+
+func (e *SelectionError) Error() string {
+	return "cannot find anything matching your selection: " + e.Selection
+}
+
+// AmbiguousSelectionError is reported when (a part of) the selection matches more than one type
+type AmbiguousSelectionError struct {
+	Selection string
+	Matches   []FullName
+}
+
+func (e *AmbiguousSelectionError) Error() string {
+	names := make([]string, 0, len(e.Matches))
+	for _, one := range e.Matches {
+		names = append(names, selectExact+string(one))
+	}
+	return fmt.Sprintf("your selection [%s] results in more than one entry, did you mean one of: %s", e.Selection, strings.Join(names, ", "))
+}
+
+// InvalidPatternError is reported when a glob ('pkg.*') or regex ('re:/.../') selection cannot be compiled
+type InvalidPatternError struct {
+	Pattern string
+	Err     error
+}
+
+func (e *InvalidPatternError) Error() string {
+	return "invalid selection pattern [" + e.Pattern + "], with error: " + e.Err.Error()
+}
+
+// RenderError is reported when a template cannot be rendered (or its output written)
+type RenderError struct {
+	Template string
+	Err      error
+}
+
+func (e *RenderError) Error() string {
+	return "failed to render [" + e.Template + "], with error: " + e.Err.Error()
+}
+
+// ConversionError is reported when the .dot output cannot be converted into the given format (e.g. svg or png)
+type ConversionError struct {
+	Format string
+	Err    error
+}
+
+func (e *ConversionError) Error() string {
+	return "failed to generate the ." + e.Format + " file, with error: " + e.Err.Error()
+}
+
+// UnsupportedFormatError is reported when the requested output format is neither produced directly nor by a conversion
+type UnsupportedFormatError struct {
+	Format string
+}
+
+func (e *UnsupportedFormatError) Error() string {
+	return "unsupported format [" + e.Format + "]: use dot, svg, png, mermaid, json or a configured template set"
+}
+
+// NoTemplatesError is reported when the configuration has no 'templates' section: nothing could be rendered
+type NoTemplatesError struct{}
+
+func (e *NoTemplatesError) Error() string {
+	return "the configuration has no 'templates' (see config.json), nothing can be rendered"
+}
+
+// remembering the first error encountered while walking the sources: the handlers have no way to return it
+func (pbs *pbstate) fail(err error) {
+	pbs.alert(err)
+	if pbs.err == nil {
+		pbs.err = err
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"strings"
+)
+
+// the fields of the excluded types are shown with the type name only (no node, no edge) instead of being dropped
+const inlineExcluded = "inline excluded types"
+
+// turns the exclusion terms (the same forms '-select' takes, see expandSelection) into the matchers.
+// unlike in the selection, a name matches every type it is the (dot separated) end of
+func compileExclusions(terms []string) ([]func(FullName) bool, error) {
+	var result []func(FullName) bool
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		if len(term) == 0 {
+			continue
+		}
+
+		match, err := selectionPattern(term)
+		if err != nil {
+			return nil, err
+		}
+		if match == nil {
+			exact := strings.HasPrefix(term, selectExact)
+			name := withoutDot(strings.TrimPrefix(term, selectExact))
+			match = func(full FullName) bool {
+				plain := withoutDot(string(full))
+				return plain == name || (!exact && strings.HasSuffix(plain, separator+name))
+			}
+		}
+		result = append(result, match)
+	}
+	return result, nil
+}
+
+// the exclusions from the config ('exclude' list) followed by the given (';' separated) ones
+func (s *Session) exclusions(extra string) ([]func(FullName) bool, error) {
+	terms := append([]string{}, s.exclude...)
+	terms = append(terms, strings.Split(extra, ";")...)
+	return compileExclusions(terms)
+}
+
+func (pbs *pbstate) isExcluded(full FullName) bool {
+	for _, match := range pbs.exclusions {
+		if match(full) {
+			return true
+		}
+	}
+	return false
+}
+
+// the type 'typ', as used in 'scope', is excluded
+func (pbs *pbstate) excludedType(scope FullName, typ string) bool {
+	if len(pbs.exclusions) == 0 || isSimpleType(typ) {
+		return false
+	}
+	// not getResolution: the excluded types may have been taken out of types237 already (see withoutExcluded)
+	if full, found := pbs.resolutions[scope][OriginalName(typ)]; found {
+		return pbs.isExcluded(full)
+	}
+	return false
+}
+
+// the field of type 'typ' is not shown at all
+func (pbs *pbstate) droppedField(scope FullName, typ string) bool {
+	return pbs.excludedType(scope, typ) && !pbs.session.Option(inlineExcluded)
+}
+
+// the types and the inclusions to show: less the excluded types and the connections from or to them.
+// the inclusions themselves are always recorded (the analyses, e.g. '-report unused', rely on them)
+func (pbs *pbstate) withoutExcluded() (map[FullName]tinfo, map[UniqueName]map[UniqueName]int) {
+	types := make(map[FullName]tinfo)
+	for full, info := range pbs.types237 {
+		if !pbs.isExcluded(full) {
+			types[full] = info
+		}
+	}
+
+	inclusions := make(map[UniqueName]map[UniqueName]int)
+	for from, tos := range pbs.inclusions {
+		if pbs.isExcluded(pbs.knownNames[UniqueName(strings.Split(string(from), ":")[0])]) {
+			continue
+		}
+		shown := make(map[UniqueName]int)
+		for to, count := range tos {
+			if !pbs.isExcluded(pbs.knownNames[to]) {
+				shown[to] = count
+			}
+		}
+		if len(shown) > 0 {
+			inclusions[from] = shown
+		}
+	}
+	return types, inclusions
+}
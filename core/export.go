@@ -0,0 +1,354 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"encoding/json"
+	"github.com/emicklei/proto"
+	"sort"
+	"strings"
+)
+
+// the layout of '-format json' output: what pbstate has resolved, for the consumption by other tools
+
+type exportDocument struct {
+	Generator  string            `json:"generator"`
+	Source     string            `json:"source"`
+	Selection  string            `json:"selection,omitempty"`
+	Files      []exportFile      `json:"files"`
+	Types      []exportType      `json:"types,omitempty"`
+	Inclusions []exportInclusion `json:"inclusions,omitempty"`
+	Unused     *unusedReport     `json:"unused,omitempty"` // see '-report unused'
+	Cycles     [][]string        `json:"cycles,omitempty"` // import cycles, e.g. ["a.proto", "b.proto", "a.proto"]
+	Packages   []exportPackage   `json:"packages,omitempty"`
+	References []exportReference `json:"references,omitempty"` // between the packages
+}
+
+type exportPackage struct {
+	Name  string   `json:"name"`
+	Files []string `json:"files"`
+}
+
+type exportReference struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+type exportFile struct {
+	Name         string   `json:"name"`
+	Package      string   `json:"package,omitempty"`
+	Syntax       string   `json:"syntax,omitempty"`
+	Dependencies []string `json:"dependencies"`
+	Public       []string `json:"public,omitempty"` // the dependencies imported with 'import public'
+	Weak         []string `json:"weak,omitempty"`   // the dependencies imported with 'import weak'
+	Missing      bool     `json:"missing,omitempty"`
+}
+
+type exportType struct {
+	FullName   FullName       `json:"fullname"`
+	Unique     UniqueName     `json:"unique"`
+	Name       string         `json:"name"`
+	Kind       string         `json:"kind"`
+	File       string         `json:"file,omitempty"`
+	Parent     FullName       `json:"parent,omitempty"`
+	Comment    string         `json:"comment,omitempty"`
+	Extensions string         `json:"extensions,omitempty"` // (proto2) ranges, e.g. "100 to 199"
+	Reserved   []string       `json:"reserved,omitempty"`   // e.g. "2, 15, 9 to 11" or "\"foo\", \"bar\""
+	Fields     []exportField  `json:"fields,omitempty"`
+	Values     []exportValue  `json:"values,omitempty"`
+	Methods    []exportMethod `json:"methods,omitempty"`
+	Hidden     int            `json:"hidden,omitempty"` // collapsed (see '-depth'): the number of the dependencies not shown
+}
+
+type exportField struct {
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	KeyType    string            `json:"keyType,omitempty"`
+	Number     int               `json:"number"`
+	Label      string            `json:"label,omitempty"` // "repeated", "optional", "required", "map", "group", "repeated group"
+	Oneof      string            `json:"oneof,omitempty"`
+	Target     FullName          `json:"target,omitempty"` // resolved type, if not a simple one
+	Comment    string            `json:"comment,omitempty"`
+	Options    map[string]string `json:"options,omitempty"`
+	Deprecated bool              `json:"deprecated,omitempty"`
+}
+
+type exportValue struct {
+	Name       string            `json:"name"`
+	Value      int               `json:"value"`
+	Comment    string            `json:"comment,omitempty"`
+	Options    map[string]string `json:"options,omitempty"`
+	Deprecated bool              `json:"deprecated,omitempty"`
+}
+
+type exportMethod struct {
+	Name           string   `json:"name"`
+	RequestType    string   `json:"requestType"`
+	RequestTarget  FullName `json:"requestTarget,omitempty"`
+	StreamsRequest bool     `json:"streamsRequest,omitempty"`
+	ReturnsType    string   `json:"returnsType"`
+	ReturnsTarget  FullName `json:"returnsTarget,omitempty"`
+	StreamsReturns bool     `json:"streamsReturns,omitempty"`
+	Comment        string   `json:"comment,omitempty"`
+}
+
+type exportInclusion struct {
+	From  FullName `json:"from"`
+	Field string   `json:"field,omitempty"`
+	To    FullName `json:"to"`
+}
+
+var isProto3 = map[bool]string{
+	false: "proto2",
+	true:  "proto3",
+}
+
+func (pbs *pbstate) exportTarget(scope FullName, typ string) FullName {
+	if isSimpleType(typ) {
+		return ""
+	}
+	if info := pbs.getResolution(scope, OriginalName(typ)); info != nil && !pbs.isExcluded(info.fullname) {
+		return info.fullname
+	}
+	return ""
+}
+
+func exportOptions(options []FieldOption, deprecated bool) (map[string]string, bool) {
+	if len(options) == 0 {
+		return nil, deprecated
+	}
+	result := make(map[string]string)
+	for _, one := range options {
+		result[one.Name] = one.Value
+	}
+	return result, deprecated
+}
+
+func fieldLabel(field *proto.NormalField) string {
+	switch {
+	case field.Repeated:
+		return "repeated"
+	case field.Optional:
+		return "optional"
+	case field.Required:
+		return "required"
+	}
+	return ""
+}
+
+func (pbs *pbstate) exportType(info tinfo) exportType {
+	one := exportType{
+		FullName: info.fullname,
+		Unique:   info.unique,
+		Name:     info.name,
+		Kind:     info.typename,
+		File:     info.filename,
+		Parent:   info.parent,
+		Comment:  info.doc,
+		Hidden:   info.hidden,
+	}
+
+	switch actual := info.object.(type) {
+	case *proto.Message:
+		for _, element := range actual.Elements {
+			switch field := element.(type) {
+			case *proto.NormalField:
+				if pbs.droppedField(info.fullname, field.Type) {
+					continue
+				}
+				next := exportField{
+					Name:    field.Name,
+					Type:    field.Type,
+					Number:  field.Sequence,
+					Label:   fieldLabel(field),
+					Target:  pbs.exportTarget(info.fullname, field.Type),
+					Comment: commentText(field.Comment, field.InlineComment),
+				}
+				next.Options, next.Deprecated = exportOptions(fieldOptions(field.Options))
+				one.Fields = append(one.Fields, next)
+			case *proto.Group:
+				if pbs.droppedField(info.fullname, field.Name) {
+					continue
+				}
+				label := "group"
+				if field.Repeated {
+					label = "repeated group"
+				}
+				one.Fields = append(one.Fields, exportField{
+					Name:    groupField(field),
+					Type:    field.Name,
+					Number:  field.Sequence,
+					Label:   label,
+					Target:  pbs.exportTarget(info.fullname, field.Name),
+					Comment: commentText(field.Comment),
+				})
+			case *proto.Extensions:
+				if len(one.Extensions) > 0 {
+					one.Extensions += ", "
+				}
+				one.Extensions += rangesText(field.Ranges)
+			case *proto.Reserved:
+				one.Reserved = append(one.Reserved, reservedText(field))
+			case *proto.MapField:
+				if pbs.droppedField(info.fullname, field.Type) {
+					continue
+				}
+				next := exportField{
+					Name:    field.Name,
+					Type:    field.Type,
+					KeyType: field.KeyType,
+					Number:  field.Sequence,
+					Label:   "map",
+					Target:  pbs.exportTarget(info.fullname, field.Type),
+					Comment: commentText(field.Comment, field.InlineComment),
+				}
+				next.Options, next.Deprecated = exportOptions(fieldOptions(field.Options))
+				one.Fields = append(one.Fields, next)
+			case *proto.Oneof:
+				for _, element := range field.Elements {
+					if group, ok := element.(*proto.Group); ok && !pbs.droppedField(info.fullname, group.Name) {
+						one.Fields = append(one.Fields, exportField{
+							Name:    groupField(group),
+							Type:    group.Name,
+							Number:  group.Sequence,
+							Label:   "group",
+							Oneof:   field.Name,
+							Target:  pbs.exportTarget(info.fullname, group.Name),
+							Comment: commentText(group.Comment),
+						})
+					}
+					if entry, ok := element.(*proto.OneOfField); ok && !pbs.droppedField(info.fullname, entry.Type) {
+						next := exportField{
+							Name:    entry.Name,
+							Type:    entry.Type,
+							Number:  entry.Sequence,
+							Oneof:   field.Name,
+							Target:  pbs.exportTarget(info.fullname, entry.Type),
+							Comment: commentText(entry.Comment, entry.InlineComment),
+						}
+						next.Options, next.Deprecated = exportOptions(fieldOptions(entry.Options))
+						one.Fields = append(one.Fields, next)
+					}
+				}
+			}
+		}
+
+	case *proto.Enum:
+		for _, element := range actual.Elements {
+			if value, ok := element.(*proto.EnumField); ok {
+				next := exportValue{Name: value.Name, Value: value.Integer, Comment: commentText(value.Comment, value.InlineComment)}
+				next.Options, next.Deprecated = exportOptions(enumFieldOptions(value))
+				one.Values = append(one.Values, next)
+			}
+		}
+
+	case *proto.Service:
+		for _, element := range actual.Elements {
+			if rpc, ok := element.(*proto.RPC); ok {
+				one.Methods = append(one.Methods, exportMethod{
+					Name:           rpc.Name,
+					RequestType:    rpc.RequestType,
+					RequestTarget:  pbs.exportTarget(info.fullname, rpc.RequestType),
+					StreamsRequest: rpc.StreamsRequest,
+					ReturnsType:    rpc.ReturnsType,
+					ReturnsTarget:  pbs.exportTarget(info.fullname, rpc.ReturnsType),
+					StreamsReturns: rpc.StreamsReturns,
+					Comment:        commentText(rpc.Comment, rpc.InlineComment),
+				})
+			}
+		}
+	}
+	return one
+}
+
+// writes what 'showInclusion' (or, without the types, 'showDependencyTree') shows as a json document
+func (pbs *pbstate) showJSON(withTypes bool) {
+	doc := exportDocument{
+		Generator: appVersion,
+		Source:    pbs.proto,
+		Selection: pbs.selection,
+		Files:     make([]exportFile, 0, len(pbs.knownFiles)),
+		Unused:    pbs.unused,
+		Cycles:    pbs.cycles,
+	}
+
+	for name, info := range pbs.knownFiles {
+		file := exportFile{
+			Name:         name,
+			Package:      info.packageName,
+			Dependencies: info.dependencies,
+			Public:       info.public,
+			Weak:         info.weak,
+			Missing:      info.missing,
+		}
+		if !info.missing {
+			file.Syntax = isProto3[info.proto3]
+		}
+		if file.Dependencies == nil {
+			file.Dependencies = []string{}
+		}
+		doc.Files = append(doc.Files, file)
+	}
+	sort.Slice(doc.Files, func(i, j int) bool { return doc.Files[i].Name < doc.Files[j].Name })
+
+	if withTypes {
+		for _, info := range pbs.types237 {
+			doc.Types = append(doc.Types, pbs.exportType(info))
+		}
+		sort.Slice(doc.Types, func(i, j int) bool { return doc.Types[i].FullName < doc.Types[j].FullName })
+
+		for from, tos := range pbs.inclusions {
+			bits := strings.Split(string(from), ":")
+			for to := range tos {
+				one := exportInclusion{
+					From: pbs.knownNames[UniqueName(bits[0])],
+					To:   pbs.knownNames[to],
+				}
+				if len(bits) > 1 {
+					one.Field = bits[1]
+				}
+				doc.Inclusions = append(doc.Inclusions, one)
+			}
+		}
+		sort.Slice(doc.Inclusions, func(i, j int) bool {
+			a, b := doc.Inclusions[i], doc.Inclusions[j]
+			if a.From != b.From {
+				return a.From < b.From
+			}
+			if a.Field != b.Field {
+				return a.Field < b.Field
+			}
+			return a.To < b.To
+		})
+	}
+
+	pbs.writeJSON(doc)
+}
+
+// writes what 'showPackageTree' shows as a json document
+func (pbs *pbstate) showJSONPackages(nodes []PackageNode, links []PackageLink) {
+	doc := exportDocument{
+		Generator: appVersion,
+		Source:    pbs.proto,
+		Selection: pbs.selection,
+		Files:     []exportFile{},
+	}
+	for _, node := range nodes {
+		doc.Packages = append(doc.Packages, exportPackage{Name: node.PackageName, Files: node.Files})
+	}
+	for _, link := range links {
+		doc.References = append(doc.References, exportReference{From: link.From, To: link.To, Count: link.Count})
+	}
+	pbs.writeJSON(doc)
+}
+
+func (pbs *pbstate) writeJSON(doc exportDocument) {
+	encoder := json.NewEncoder(pbs.target())
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(doc); err != nil {
+		pbs.fail(err)
+	}
+}
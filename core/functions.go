@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package core
 
 import (
 	"fmt"
@@ -19,9 +19,9 @@ func title(t string) string {
 	return "title(" + t + ")"
 }
 
-func color(name string) string {
-	if g_config != nil {
-		if colors, found := g_config["colors"]; found {
+func (s *Session) color(name string) string {
+	if s.config != nil {
+		if colors, found := s.config["colors"]; found {
 			colorMap := colors.(map[string]interface{})
 			if colorMap != nil {
 				if maps2, found := colorMap[name]; found {
@@ -37,9 +37,9 @@ func color(name string) string {
 	return c
 }
 
-func settings(key string) string {
-	if g_config != nil {
-		if colors, found := g_config["settings"]; found {
+func (s *Session) settings(key string) string {
+	if s.config != nil {
+		if colors, found := s.config["settings"]; found {
 			settingsMap := colors.(map[string]interface{})
 			if settingsMap != nil {
 				if value, found := settingsMap[key]; found {
@@ -54,10 +54,13 @@ func settings(key string) string {
 }
 
 // type FuncMap map[string]interface{}
-var templFuncs = template.FuncMap{
-	"lower":    strings.ToLower,
-	"title":    title,
-	"settings": settings,
-	"color":    color,
-	"oneword":  oneword,
+func (s *Session) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"lower":    strings.ToLower,
+		"title":    title,
+		"settings": s.settings,
+		"color":    s.color,
+		"oneword":  oneword,
+		"option":   s.Option,
+	}
 }
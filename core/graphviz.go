@@ -0,0 +1,79 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"github.com/seamia/protodot/graphviz"
+	"github.com/seamia/tools/support"
+)
+
+// Convert runs 'graphviz' on the in-memory .dot source, e.g. format = "svg"
+func (s *Session) Convert(ctx context.Context, dot []byte, format string) ([]byte, error) {
+	binary, err := support.GetLocation(s.config, "graphviz")
+	if err != nil {
+		return nil, err
+	}
+	return graphviz.Convert(ctx, binary, dot, format)
+}
+
+// Graphviz (optionally, see 'generate .svg file' and 'generate .png file' options) runs 'graphviz' on the given .dot file
+// and then the custom action (if any). returns the first failure: the action is not run after a failed conversion
+func (s *Session) Graphviz(src string) error {
+
+	svgPath := ""
+	pngPath := ""
+
+	action := ""
+	if tmp, err := support.GetLocation(s.config, "action"); err == nil {
+		action = tmp
+	}
+
+	svg, png := s.Option(generateSvg), s.Option(generatePng)
+	if !s.producesDot() {
+		svg, png = false, false
+	}
+	if svg && s.native() {
+		// already produced by Process
+		svg = false
+		if target := src + ".svg"; Exists(target) {
+			svgPath = target
+		}
+	}
+	if png || svg {
+
+		binary, err := support.GetLocation(s.config, "graphviz")
+		if err != nil || len(binary) == 0 {
+			s.alert("failed to get 'graphviz' location from config file")
+			return &ConversionError{Format: "svg/png", Err: errors.New("'graphviz' location is not configured")}
+		}
+		if svg {
+			s.status("generating .svg file")
+			if svgPath, err = graphviz.Generate(binary, src, "svg"); err != nil {
+				s.alert("error on exec", err)
+				return &ConversionError{Format: "svg", Err: err}
+			}
+		}
+
+		if png {
+			s.status("generating .png file")
+			if pngPath, err = graphviz.Generate(binary, src, "png"); err != nil {
+				s.alert("error on exec", err)
+				return &ConversionError{Format: "png", Err: err}
+			}
+		}
+	}
+
+	if len(action) > 0 {
+		output, err := graphviz.Action(action, src, svgPath, pngPath)
+		if err != nil {
+			s.alert("Failed to execute custom action [", action, "] due to", err)
+			return err
+		}
+		s.status("custom action said:", string(output))
+	}
+	return nil
+}
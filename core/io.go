@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package core
 
 import (
 	"io"
@@ -14,6 +14,7 @@ type CreateOnWrite struct {
 	name   string
 	writer io.Writer
 	err    error
+	log    *logger
 }
 
 func NewCreateOnWrite(name string) *CreateOnWrite {
@@ -26,7 +27,7 @@ func (cow *CreateOnWrite) Write(p []byte) (n int, err error) {
 	}
 
 	if cow.writer == nil {
-		status("creating file:", cow.name)
+		cow.log.status("creating file:", cow.name)
 		cow.writer, cow.err = os.Create(cow.name)
 	}
 
@@ -62,21 +63,10 @@ func (fw *ForkWriter) Write(p []byte) (n int, err error) {
 	return
 }
 
-func createDirIfMissing(name string) {
-	expanded := os.ExpandEnv(name)
-	if len(expanded) > 0 {
-		if !Exists(expanded) {
-			status("creating missing direcory:", expanded)
-			os.MkdirAll(expanded, 0755) // warning: dropping error on the floor here
-		}
-	}
-}
-
-func loadFileAsBytes(name string) []byte {
-	if bytes, err := ioutil.ReadFile(name); err == nil {
-		return bytes
-	} else {
-		assert("failed to open/read file [", name, "], with error:", err)
+func loadFileAsBytes(name string) ([]byte, error) {
+	bytes, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, &MissingFileError{Name: name, Err: err}
 	}
-	return nil
+	return bytes, nil
 }
@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package core
 
 import (
 	"errors"
@@ -16,18 +16,18 @@ import (
 	"strings"
 )
 
-func downloadFromUrl(url, filename string) (io.Reader, error) {
+func (s *Session) downloadFromUrl(url, filename string) (io.Reader, error) {
 
-	if downloads, err := support.GetLocation(g_config, "downloads"); err == nil && len(downloads) > 0 {
+	if downloads, err := support.GetLocation(s.config, "downloads"); err == nil && len(downloads) > 0 {
 		if len(filename) == 0 {
 			tokens := strings.Split(url, "/")
 			filename = path.Join(downloads, tokens[len(tokens)-1])
-			trace("Downloading", url, "to", filename)
+			s.trace("Downloading", url, "to", filename)
 		} else {
 			filename = path.Join(downloads, filename)
 		}
 	} else {
-		alert("the 'download' location is not set")
+		s.alert("the 'download' location is not set")
 		if err == nil {
 			err = errors.New("the 'download' location is empty")
 		}
@@ -35,25 +35,25 @@ func downloadFromUrl(url, filename string) (io.Reader, error) {
 	}
 
 	if Exists(filename) {
-		trace("file already exists. ", url, " maps to ", filename)
+		s.trace("file already exists. ", url, " maps to ", filename)
 		return os.Open(filename) // return from cache
 	}
 
 	response, err := http.Get(url)
 	if err != nil {
-		alert("Error while downloading", url, "-", err)
+		s.alert("Error while downloading", url, "-", err)
 		return nil, err
 	}
 	defer response.Body.Close()
 
 	if response.StatusCode != 200 {
-		alert("got invalid status.code", response.StatusCode, "while downloading", url)
+		s.alert("got invalid status.code", response.StatusCode, "while downloading", url)
 		return nil, errors.New("failed to download: " + response.Status)
 	}
 
 	output, err := os.Create(filename)
 	if err != nil {
-		alert("Error while creating", filename, "-", err)
+		s.alert("Error while creating", filename, "-", err)
 		return nil, err
 	}
 	defer output.Close()
@@ -64,15 +64,15 @@ func downloadFromUrl(url, filename string) (io.Reader, error) {
 		return nil, err
 	}
 
-	trace(n, "bytes downloaded.")
+	s.trace(n, "bytes downloaded.")
 	return os.Open(filename)
 }
 
-func downloadFile(name string) (io.Reader, error) { // todo: need this here?
+func (s *Session) downloadFile(name string) (io.Reader, error) { // todo: need this here?
 
 	u, err := url.Parse("https://" + name)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	local := support.Hash([]byte(name))
@@ -90,5 +90,5 @@ func downloadFile(name string) (io.Reader, error) { // todo: need this here?
 		}
 	}
 
-	return downloadFromUrl(u.String(), local)
+	return s.downloadFromUrl(u.String(), local)
 }
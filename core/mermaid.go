@@ -0,0 +1,218 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"fmt"
+	"github.com/emicklei/proto"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// mermaid has no notion of '<' and '>' in the member types: generics are written as 'map~K, V~'
+func mermaidType(typ string) string {
+	return strings.NewReplacer("<", "~", ">", "~").Replace(typ)
+}
+
+func mermaidLabel(text string) string {
+	return strings.Replace(text, "\"", "#quot;", -1)
+}
+
+var typename2stereotype = map[string]string{
+	typenameMessage: "message",
+	typenameEnum:    "enumeration",
+	typenameService: "service",
+	typenameMissing: "missing",
+	typenameExtend:  "extend",
+}
+
+var typename2arrow = map[string]string{
+	typenameMessage: "-->",
+	typenameEnum:    "..>",
+	typenameMissing: "..>",
+}
+
+var import2arrow = map[string]string{
+	"":           "-->",
+	importPublic: "==>",
+	importWeak:   "-.->",
+}
+
+func (pbs *pbstate) mermaidMembers(info tinfo) []string {
+	var members []string
+	switch actual := info.object.(type) {
+	case *proto.Message:
+		for _, element := range actual.Elements {
+			switch field := element.(type) {
+			case *proto.NormalField:
+				if pbs.droppedField(info.fullname, field.Type) {
+					continue
+				}
+				typ := field.Type
+				if field.Repeated {
+					typ += "[]"
+				}
+				members = append(members, "+"+typ+" "+field.Name)
+			case *proto.Group:
+				if pbs.droppedField(info.fullname, field.Name) {
+					continue
+				}
+				typ := field.Name
+				if field.Repeated {
+					typ += "[]"
+				}
+				members = append(members, "+"+typ+" "+groupField(field))
+			case *proto.Extensions:
+				members = append(members, "extensions "+rangesText(field.Ranges))
+			case *proto.MapField:
+				if pbs.droppedField(info.fullname, field.Type) {
+					continue
+				}
+				members = append(members, "+"+mermaidType("map<"+field.KeyType+", "+field.Type+">")+" "+field.Name)
+			case *proto.Oneof:
+				for _, element := range field.Elements {
+					switch one := element.(type) {
+					case *proto.OneOfField:
+						if !pbs.droppedField(info.fullname, one.Type) {
+							members = append(members, "+"+one.Type+" "+one.Name+" [oneof "+field.Name+"]")
+						}
+					case *proto.Group:
+						if pbs.droppedField(info.fullname, one.Name) {
+							continue
+						}
+						members = append(members, "+"+one.Name+" "+groupField(one)+" [oneof "+field.Name+"]")
+					}
+				}
+			}
+		}
+
+	case *proto.Enum:
+		for _, element := range actual.Elements {
+			if value, ok := element.(*proto.EnumField); ok {
+				members = append(members, fmt.Sprintf("%s = %d", value.Name, value.Integer))
+			}
+		}
+
+	case *proto.Service:
+		for _, element := range actual.Elements {
+			if rpc, ok := element.(*proto.RPC); ok {
+				members = append(members, fmt.Sprintf("+%s(%s) %s",
+					rpc.Name,
+					strings.TrimSpace(isStreaming[rpc.StreamsRequest]+" "+rpc.RequestType),
+					strings.TrimSpace(isStreaming[rpc.StreamsReturns]+" "+rpc.ReturnsType)))
+			}
+		}
+	}
+	return members
+}
+
+// writes the same content 'showInclusion' does, as mermaid's classDiagram
+func (pbs *pbstate) showMermaid() {
+	w := pbs.target()
+	fmt.Fprintln(w, "%%", appVersion)
+	fmt.Fprintln(w, "%% source:", pbs.proto)
+	if len(pbs.selection) > 0 {
+		fmt.Fprintln(w, "%% selection:", pbs.selection)
+	}
+	fmt.Fprintln(w, "classDiagram")
+	fmt.Fprintln(w, "direction LR")
+
+	// the maps have no order: keep the output stable
+	types := make([]tinfo, 0, len(pbs.types237))
+	for _, info := range pbs.types237 {
+		if _, found := typename2stereotype[info.typename]; found {
+			types = append(types, info)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].unique < types[j].unique })
+
+	for _, info := range types {
+		label := info.name
+		if info.typename == typenameExtend {
+			label = "extend " + label
+		}
+		fmt.Fprintf(w, "class %s[\"%s\"] {\n", info.unique, mermaidLabel(label))
+		fmt.Fprintf(w, "\t<<%s>>\n", typename2stereotype[info.typename])
+		for _, member := range pbs.mermaidMembers(info) {
+			fmt.Fprintln(w, "\t"+member)
+		}
+		if info.hidden > 0 {
+			fmt.Fprintf(w, "\t%d hidden dependencies\n", info.hidden)
+		}
+		fmt.Fprintln(w, "}")
+		if pbs.isUnused(info.fullname) {
+			fmt.Fprintf(w, "style %s fill:%s\n", info.unique, pbs.session.color("unused.highlight"))
+		}
+	}
+
+	var edges []string
+	for from, tos := range pbs.inclusions {
+		bits := strings.Split(string(from), ":")
+		for to := range tos {
+			arrow, found := typename2arrow[pbs.types237[pbs.knownNames[to]].typename]
+			if !found {
+				continue
+			}
+			if len(bits) > 1 && bits[1] == extendeeField && pbs.types237[pbs.knownNames[UniqueName(bits[0])]].typename == typenameExtend {
+				edges = append(edges, bits[0]+" ..|> "+string(to)+" : extends")
+				continue
+			}
+			edge := bits[0] + " " + arrow + " " + string(to)
+			if len(bits) > 1 {
+				edge += " : " + bits[1]
+			}
+			edges = append(edges, edge)
+		}
+	}
+	if pbs.session.Option(showContainment) {
+		for _, one := range pbs.containments() {
+			edges = append(edges, one.From+" *-- "+string(one.To))
+		}
+	}
+	sort.Strings(edges)
+	for _, edge := range edges {
+		fmt.Fprintln(w, edge)
+	}
+}
+
+// writes the same content 'showDependencyTree' does, as mermaid's flowchart (classDiagram has no use here)
+func (pbs *pbstate) showMermaidImports(getID func(string) string, fileName func(string) string) {
+	w := pbs.target()
+	fmt.Fprintln(w, "%%", appVersion)
+	fmt.Fprintln(w, "%% source:", pbs.proto)
+	fmt.Fprintln(w, "flowchart LR")
+
+	names := make([]string, 0, len(pbs.knownFiles))
+	for name := range pbs.knownFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		info := pbs.knownFiles[name]
+		label := mermaidLabel(info.packageName + "<br/>" + fileName(info.fileName))
+		if info.missing {
+			label += "<br/>(missing)"
+		}
+		fmt.Fprintf(w, "%s[\"%s\"]\n", getID(name), label)
+	}
+	inCycle := cycleEdges(pbs.cycles)
+	var cycle []string // the indexes of the links, for 'linkStyle'
+	link := 0
+	for _, name := range names {
+		info := pbs.knownFiles[name]
+		for _, toname := range info.dependencies {
+			fmt.Fprintln(w, getID(name), import2arrow[info.importKind(toname)], getID(toname))
+			if inCycle[name+">"+toname] {
+				cycle = append(cycle, strconv.Itoa(link))
+			}
+			link++
+		}
+	}
+	if len(cycle) > 0 {
+		fmt.Fprintf(w, "linkStyle %s stroke:%s\n", strings.Join(cycle, ","), pbs.session.color("import.cycle"))
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"github.com/seamia/protodot/svg"
+	"github.com/seamia/tools/support"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const nativeSvg = "native .svg renderer"
+
+// the native renderer is used when asked for explicitly or when 'graphviz' is not available
+func (s *Session) native() bool {
+	if s.Option(nativeSvg) {
+		return true
+	}
+	binary, err := support.GetLocation(s.config, "graphviz")
+	if err != nil || len(binary) == 0 {
+		return true
+	}
+	if _, err := exec.LookPath(binary); err != nil {
+		s.trace("graphviz [", binary, "] is not available, using the native renderer:", err)
+		return true
+	}
+	return false
+}
+
+func (s *Session) newGraph(label string) *svg.Graph {
+	graph := svg.Graph{
+		Label:    label,
+		FontName: s.settings("node.font.name"),
+	}
+	if size, err := strconv.Atoi(s.settings("node.font.size")); err == nil {
+		graph.FontSize = size
+	}
+	return &graph
+}
+
+var relationship2color = map[string]string{
+	typenameEnum:    "relationship.enum",
+	typenameMessage: "relationship.message",
+	typenameMissing: "relationship.missing",
+}
+
+// builds the native counterpart of what 'showInclusion' writes
+func (pbs *pbstate) inclusionGraph() *svg.Graph {
+	s := pbs.session
+	graph := s.newGraph(pbs.pkg)
+
+	for _, info := range pbs.types237 {
+		if info.typename == typenameRPC {
+			// rpc is a part of its service
+			continue
+		}
+
+		node := svg.Node{
+			ID:         string(info.unique),
+			Title:      strings.TrimSpace(string(info.fullname) + "\n" + info.doc),
+			Header:     info.name,
+			HeaderFill: s.color(info.typename + ".header"),
+			Fill:       s.color(info.typename + ".background"),
+		}
+		if pbs.isUnused(info.fullname) {
+			node.HeaderFill = s.color("unused.highlight")
+		}
+		for _, one := range info.rows {
+			next := svg.Row{Cells: one.cells, Port: one.port, Span: one.span, Title: one.title, Strike: one.deprecated}
+			if len(one.color) > 0 {
+				next.Fill = s.color(one.color)
+			}
+			node.Rows = append(node.Rows, next)
+		}
+		graph.Nodes = append(graph.Nodes, &node)
+	}
+
+	for from, tos := range pbs.inclusions {
+		bits := strings.Split(string(from), ":")
+		for to := range tos {
+			edge := svg.Edge{
+				From: bits[0],
+				To:   string(to),
+			}
+			if len(bits) > 1 {
+				edge.Port = bits[1]
+			}
+			if color, found := relationship2color[pbs.types237[pbs.knownNames[to]].typename]; found {
+				edge.Color = s.color(color)
+			}
+			if edge.Port == extendeeField && pbs.types237[pbs.knownNames[UniqueName(edge.From)]].typename == typenameExtend {
+				edge.Port, edge.Color, edge.Dashed = "", s.color("relationship.extend"), true
+			}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	if s.Option(showContainment) {
+		for _, one := range pbs.containments() {
+			graph.Edges = append(graph.Edges, svg.Edge{From: one.From, To: string(one.To), Color: s.color("relationship.nested"), Dashed: true})
+		}
+	}
+	sorted(graph)
+	return graph
+}
+
+// builds the native counterpart of what 'showDependencyTree' writes
+func (pbs *pbstate) dependencyGraph(getID func(string) string, fileName func(string) string) *svg.Graph {
+	s := pbs.session
+	graph := s.newGraph(pbs.pkg)
+	inCycle := cycleEdges(pbs.cycles)
+
+	for name, info := range pbs.knownFiles {
+		node := svg.Node{
+			ID:         getID(name),
+			Title:      info.packageName,
+			Header:     info.packageName,
+			HeaderFill: "cornsilk",
+			Fill:       "cornsilk",
+			Rows:       []svg.Row{{Cells: []string{fileName(info.fileName)}}},
+		}
+		if info.missing {
+			node.HeaderFill = s.color("missing.header")
+			node.Fill = s.color("missing.background")
+		}
+		graph.Nodes = append(graph.Nodes, &node)
+
+		for _, toname := range info.dependencies {
+			edge := svg.Edge{From: getID(name), To: getID(toname)}
+			switch info.importKind(toname) {
+			case importPublic:
+				edge.Color = s.color("import.public")
+			case importWeak:
+				edge.Color, edge.Dashed = s.color("import.weak"), true
+			}
+			if inCycle[name+">"+toname] {
+				edge.Color = s.color("import.cycle")
+			}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	sorted(graph)
+	return graph
+}
+
+// the maps the graphs are built from have no order: make the produced .svg stable
+func sorted(graph *svg.Graph) {
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.Port != b.Port {
+			return a.Port < b.Port
+		}
+		return a.To < b.To
+	})
+}
+
+// renders the diagram of the last processing, if any
+func (pbs *pbstate) nativeSvg() ([]byte, error) {
+	if pbs.graph == nil {
+		return nil, errors.New("there is nothing to render")
+	}
+	buffer := bytes.NewBuffer(nil)
+	if err := pbs.graph.Write(buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// writes 'src'.svg next to the given .dot file, the same way graphviz.Generate does
+func (pbs *pbstate) writeNativeSvg(src string) (string, error) {
+	data, err := pbs.nativeSvg()
+	if err != nil {
+		return "", err
+	}
+	target := src + ".svg"
+	file, err := os.Create(target)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err := file.Write(data); err != nil {
+		return "", err
+	}
+	return target, nil
+}
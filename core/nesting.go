@@ -0,0 +1,105 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/seamia/tools/support"
+	"sort"
+)
+
+const (
+	showContainment = "show containment edges"
+	clusterNested   = "cluster nested types"
+)
+
+// the full name of the message the type is declared in, empty for the top level declarations
+func enclosingType(parent proto.Visitee) FullName {
+	switch parent.(type) {
+	case *proto.Message, *proto.Group, *proto.Oneof:
+		return FullName(getParent(parent))
+	}
+	return ""
+}
+
+// is the given type declared inside of another one present in the current output?
+func (pbs *pbstate) isNested(info tinfo) bool {
+	if len(info.parent) == 0 || info.typename == typenameRPC || info.typename == typenameExtend {
+		return false
+	}
+	parent, found := pbs.types237[info.parent]
+	return found && parent.typename == typenameMessage
+}
+
+// parent (From) contains nested type (To), ordered to keep the output stable
+func (pbs *pbstate) containments() []Relationship {
+	var result []Relationship
+	for _, info := range pbs.types237 {
+		if pbs.isNested(info) {
+			result = append(result, Relationship{
+				From:   string(pbs.types237[info.parent].unique),
+				To:     info.unique,
+				ToName: info.name,
+				ToType: info.fullname,
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].From != result[j].From {
+			return result[i].From < result[j].From
+		}
+		return result[i].To < result[j].To
+	})
+	return result
+}
+
+// writes the given types with the 'entry' template, placing the nested ones into the clusters of their parents, if asked to
+func (pbs *pbstate) writeEntries(members []tinfo, entry string) {
+	if !pbs.session.Option(clusterNested) {
+		for _, info := range members {
+			pbs.applyTemplate(entry, info.raw)
+		}
+		return
+	}
+
+	present := make(map[FullName]bool)
+	for _, info := range members {
+		present[info.fullname] = true
+	}
+	children := make(map[FullName][]tinfo)
+	var roots []tinfo
+	for _, info := range members {
+		if pbs.isNested(info) && present[info.parent] {
+			children[info.parent] = append(children[info.parent], info)
+		} else {
+			roots = append(roots, info)
+		}
+	}
+
+	var write func(info tinfo)
+	write = func(info tinfo) {
+		nested := children[info.fullname]
+		if len(nested) == 0 {
+			pbs.applyTemplate(entry, info.raw)
+			return
+		}
+
+		data := Cluster{
+			ProtoName:       string(info.fullname),
+			ProtoNameKosher: support.NameToId(string(info.fullname), 12),
+			ShortName:       info.name,
+		}
+		pbs.applyTemplate("nested.prefix", data)
+		pbs.applyTemplate(entry, info.raw)
+		for _, one := range nested {
+			write(one)
+		}
+		pbs.applyTemplate("nested.suffix", data)
+	}
+
+	for _, info := range roots {
+		write(info)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/seamia/protodot/svg"
+	"github.com/seamia/tools/support"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	selectImports  = "imports"
+	selectPackages = "packages"
+	selectUsers    = "<" // e.g. "<.Money": the users of the type
+	noPackage      = "(no package)"
+)
+
+func packageID(name string) string {
+	return "P" + support.NameToId(name, 16)
+}
+
+func packageOf(info *pkgInfo) string {
+	if len(info.packageName) == 0 {
+		return noPackage
+	}
+	return info.packageName
+}
+
+// collapses the known files into their packages: the links are the type references crossing the packages
+func (pbs *pbstate) packageDependencies() ([]PackageNode, []PackageLink) {
+	files := make(map[string][]string)
+	for name, info := range pbs.knownFiles {
+		if !info.missing {
+			files[packageOf(info)] = append(files[packageOf(info)], name)
+		}
+	}
+
+	nodes := make([]PackageNode, 0, len(files))
+	for name, members := range files {
+		sort.Strings(members)
+		nodes = append(nodes, PackageNode{NodeName: packageID(name), PackageName: name, Files: members})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].PackageName < nodes[j].PackageName })
+
+	counts := make(map[[2]string]int)
+	for from, tos := range pbs.references() {
+		source, found := pbs.knownFiles[pbs.types237[from].filename]
+		if !found {
+			continue
+		}
+		for _, to := range tos {
+			target, found := pbs.knownFiles[pbs.types237[to].filename]
+			if !found || packageOf(source) == packageOf(target) {
+				continue
+			}
+			counts[[2]string{packageOf(source), packageOf(target)}]++
+		}
+	}
+
+	links := make([]PackageLink, 0, len(counts))
+	for key, count := range counts {
+		links = append(links, PackageLink{From: key[0], To: key[1], Count: count})
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].From != links[j].From {
+			return links[i].From < links[j].From
+		}
+		return links[i].To < links[j].To
+	})
+	return nodes, links
+}
+
+// '-select packages': the packages and the number of type references between them
+func (pbs *pbstate) showPackageTree() {
+	if pbs.diveDepth != 0 {
+		pbs.fail(errors.New("package dependency tree can only be shown from the root"))
+		return
+	}
+
+	nodes, links := pbs.packageDependencies()
+
+	switch pbs.format {
+	case FormatMermaid:
+		pbs.showMermaidPackages(nodes, links)
+		return
+	case FormatJSON:
+		pbs.showJSONPackages(nodes, links)
+		return
+	}
+
+	payload := PBS{
+		Package:    pbs.pkg,
+		Protoname:  pbs.proto,
+		AppVersion: appVersion,
+		Timestamp:  time.Now().Format(time.RFC850),
+		Selection:  "(package dependency)",
+		Options:    "",
+	}
+
+	pbs.applyTemplate("imports.header", payload)
+
+	pbs.applyTemplate("comment", "nodes")
+	for _, node := range nodes {
+		pbs.applyTemplate("packages.node", node)
+	}
+
+	pbs.applyTemplate("comment", "connections")
+	for _, link := range links {
+		link.From, link.To = packageID(link.From), packageID(link.To)
+		pbs.applyTemplate("packages.connection", link)
+	}
+
+	pbs.applyTemplate("imports.footer", payload)
+	pbs.graph = pbs.packageGraph(nodes, links)
+}
+
+// builds the native counterpart of what 'showPackageTree' writes
+func (pbs *pbstate) packageGraph(nodes []PackageNode, links []PackageLink) *svg.Graph {
+	graph := pbs.session.newGraph(pbs.pkg)
+	for _, one := range nodes {
+		node := svg.Node{
+			ID:         one.NodeName,
+			Title:      one.PackageName,
+			Header:     one.PackageName,
+			HeaderFill: "cornsilk",
+			Fill:       "cornsilk",
+		}
+		for _, file := range one.Files {
+			node.Rows = append(node.Rows, svg.Row{Cells: []string{file}})
+		}
+		graph.Nodes = append(graph.Nodes, &node)
+	}
+	for _, link := range links {
+		graph.Edges = append(graph.Edges, svg.Edge{From: packageID(link.From), To: packageID(link.To), Label: strconv.Itoa(link.Count)})
+	}
+	sorted(graph)
+	return graph
+}
+
+func (pbs *pbstate) showMermaidPackages(nodes []PackageNode, links []PackageLink) {
+	w := pbs.target()
+	fmt.Fprintln(w, "%%", appVersion)
+	fmt.Fprintln(w, "%% source:", pbs.proto)
+	fmt.Fprintln(w, "flowchart LR")
+	for _, node := range nodes {
+		fmt.Fprintf(w, "%s[\"%s\"]\n", node.NodeName, mermaidLabel(node.PackageName))
+	}
+	for _, link := range links {
+		fmt.Fprintf(w, "%s -->|%d| %s\n", packageID(link.From), link.Count, packageID(link.To))
+	}
+}
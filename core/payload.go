@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package core
 
 type PBS struct {
 	Package    string
@@ -28,11 +28,28 @@ type Cluster struct {
 	ShortName       string
 }
 
+// option of a field or an enum value, e.g. 'json_name = "id"' or '(validate.rules).string.min_len = 1'
+type FieldOption struct {
+	Name  string
+	Value string
+}
+
 type EnumPayload struct {
+	Name       string
+	Value      string
+	Unique     UniqueName
+	FullName   FullName
+	Comment    string
+	Options    []FieldOption
+	Deprecated bool
+}
+
+// the type beyond the '-depth' limit, with 'Hidden' dependencies not shown
+type CollapsedNode struct {
 	Name     string
-	Value    string
 	Unique   UniqueName
 	FullName FullName
+	Hidden   int
 }
 
 type RPC struct {
@@ -41,12 +58,14 @@ type RPC struct {
 	ReturnsType    string
 	StreamsRequest string
 	StreamsReturns string
+	Comment        string
 }
 
 type ServicePayload struct {
 	Name     string
 	Unique   UniqueName
 	FullName FullName
+	Comment  string
 }
 
 type ImportNode struct {
@@ -56,7 +75,22 @@ type ImportNode struct {
 	Status      string
 }
 
+type PackageNode struct {
+	NodeName    string
+	PackageName string
+	Files       []string
+}
+
+// 'Count' type references from the package 'From' to the package 'To'
+type PackageLink struct {
+	From  string
+	To    string
+	Count int
+}
+
 type ImportLink struct {
-	From string
-	To   string
+	From  string
+	To    string
+	Kind  string // "public", "weak" or empty
+	Cycle bool   // the import is a part of an import cycle
 }
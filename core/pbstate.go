@@ -0,0 +1,1470 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/emicklei/proto"
+	"github.com/seamia/protodot/plus"
+	"github.com/seamia/protodot/svg"
+	"github.com/seamia/tools/support"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Kind int
+
+const (
+	Unknown Kind = 0
+	Simple  Kind = 1 + iota
+	Enum
+	Message
+	Missing
+)
+
+const (
+	typenameRPC     = "rpc"
+	typenameService = "service"
+	typenameEnum    = "enum"
+	typenameMessage = "message"
+	typenameMissing = "missing"
+	typenameExtend  = "extend"
+
+	extendeeField = "extendee" // pseudo field, connecting 'extend' with the message it extends
+
+	appVersion     = "generated by github.com/seamia/protodot"
+	EntryGenerated = "generated"
+	generateSvg    = "generate .svg file"
+	generatePng    = "generate .png file"
+)
+
+// use explicit string type to alleviate potential mismatch problems
+type OriginalName string // name as it appears in the source
+type FullName string     // fully qualified name = enough to identify the target (within given set of the source files). may have '.' in it
+type UniqueName string   // a short alias for a FullName
+
+type tinfo struct {
+	fullname FullName   // "one.two.three.WhatEver"
+	unique   UniqueName // "WhatEver$1"
+	name     string     // "WhatEver"
+
+	typename string // "enum", ...
+	filename string
+	comment  string
+	raw      string
+	rows     []row  // the content of 'raw', for the native renderer
+	doc      string // leading comment of the declaration
+	hidden   int    // collapsed (see '-depth'): the number of the dependencies not shown
+
+	protopack string
+	parent    FullName // full type of the parent
+	object    interface{}
+}
+
+type pkgInfo struct {
+	packageName  string
+	fileName     string
+	dependencies []string
+	public       []string // the dependencies imported with 'import public'
+	weak         []string // the dependencies imported with 'import weak'
+	missing      bool
+	proto3       bool
+}
+
+type pbstate struct {
+	knownFiles  map[string]*pkgInfo
+	types237    map[FullName]tinfo
+	inclusions  map[UniqueName]map[UniqueName]int
+	resolutions map[FullName]map[OriginalName]FullName // maps full.name + short.type to full.type
+	diveDepth   int
+	counter     int
+	knownNames  map[UniqueName]FullName // maps 'unique' to 'full'
+	dive        bool
+	proto       string
+	pkg         string
+	rootDir     string
+	writer      *ForkWriter
+	outputFile  string
+	selection   string
+	incMapping  map[string]string
+	session     *Session
+	graph       *svg.Graph // the same diagram as the one written into 'writer', for the native renderer
+	inMemory    bool       // do not create output file, use only the provided writers
+	format      string     // one of the formats produced directly (see 'format2extension'), "dot" if empty
+	report      string     // see Session.Report
+	unused      *unusedReport
+	depth       int                    // see Session.Depth
+	exclusions  []func(FullName) bool  // see Session.Exclude
+	cycles      [][]string             // see importCycles
+	roots       []string               // see isRoot
+	unresolved  map[string]bool        // the files referring to the types not found (e.g. the ones of the imports that failed to load)
+	extensions  map[FullName]string    // the declared extensions (e.g. the custom options) and the files declaring them
+	optionUses  map[string][]optionUse // the custom options every file sets, see handleOptionUses
+	err         error
+}
+
+const (
+	importPublic = "public"
+	importWeak   = "weak"
+)
+
+// "public", "weak" or "" (for the regular import) of the given dependency
+func (info *pkgInfo) importKind(dependency string) string {
+	for _, one := range info.public {
+		if one == dependency {
+			return importPublic
+		}
+	}
+	for _, one := range info.weak {
+		if one == dependency {
+			return importWeak
+		}
+	}
+	return ""
+}
+
+func (pbs *pbstate) full2info(name FullName) *tinfo {
+	if back, found := pbs.types237[name]; found {
+		return &back
+	}
+	return nil
+}
+
+func (pbs *pbstate) unique2info(name UniqueName) *tinfo {
+	if full, found := pbs.knownNames[name]; found {
+		return pbs.full2info(full)
+	}
+	return nil
+}
+
+func (pbs *pbstate) currentPkgInfo() *pkgInfo {
+	if pbs != nil && pbs.knownFiles != nil && len(pbs.proto) > 0 {
+		if info, found := pbs.knownFiles[pbs.proto]; found {
+			return info
+		}
+	}
+	pbs.fail(errors.New("somehow there is no pkgInfo available for [" + pbs.proto + "]"))
+	return &pkgInfo{}
+}
+
+func NewPbs(s *Session) *pbstate {
+	one := pbstate{session: s}
+	one.knownFiles = make(map[string]*pkgInfo)
+	one.types237 = make(map[FullName]tinfo)
+	one.inclusions = make(map[UniqueName]map[UniqueName]int)
+	one.resolutions = make(map[FullName]map[OriginalName]FullName)
+
+	one.counter = 100
+	one.knownNames = make(map[UniqueName]FullName)
+	one.unresolved = make(map[string]bool)
+	one.extensions = make(map[FullName]string)
+	one.optionUses = make(map[string][]optionUse)
+
+	one.dive = true
+
+	one.writer = NewForkWriter()
+
+	return &one
+}
+
+func (pbs *pbstate) AddWriter(target io.Writer) {
+	pbs.writer.AddWriter(target)
+}
+
+func (pbs *pbstate) target() io.Writer {
+	if pbs.writer != nil {
+		return pbs.writer
+	} else {
+		return os.Stdout
+	}
+}
+
+func (pbs *pbstate) addIncMapping(mapping map[string]string) {
+	if mapping != nil && len(mapping) > 0 {
+		if pbs.incMapping == nil {
+			pbs.incMapping = make(map[string]string)
+		}
+		for k, v := range mapping {
+			pbs.incMapping[k] = v
+		}
+	}
+}
+
+func (pbs *pbstate) getUniqueName(short OriginalName, full FullName) UniqueName {
+
+	if got, found := pbs.knownNames[UniqueName(short)]; found && got == full {
+		return UniqueName(short)
+	}
+
+	name := UniqueName(fmt.Sprintf("Ja_%d", pbs.counter))
+	pbs.counter++
+	pbs.knownNames[name] = full
+	return UniqueName(name)
+}
+
+func (pbs *pbstate) addResolution(scope FullName, shorttype OriginalName, fulltype FullName) {
+
+	if _, found := pbs.resolutions[scope]; !found {
+		pbs.resolutions[scope] = make(map[OriginalName]FullName)
+	}
+
+	pbs.resolutions[scope][shorttype] = fulltype
+}
+
+func (pbs *pbstate) getResolution(scope FullName, shorttype OriginalName) *tinfo {
+
+	if fulltype, found := pbs.resolutions[scope][shorttype]; found {
+		if info, found := pbs.types237[fulltype]; found {
+			return &info
+		}
+	}
+
+	// the type has failed to resolve and has been recorded as missing (see recordMissingType)
+	if info, found := pbs.types237[missingName(shorttype)]; found {
+		return &info
+	}
+
+	// alert("*** failed to resolve type:", shorttype) - it is okay to fail resolutuin (while we're resolving)
+	return nil
+}
+
+func (pbs *pbstate) recordInclusion(from UniqueName, field string, to UniqueName) {
+	fullFrom := from
+	if len(field) > 0 {
+		fullFrom += UniqueName(":" + field)
+	}
+
+	if _, there := pbs.inclusions[fullFrom]; !there {
+		pbs.inclusions[fullFrom] = make(map[UniqueName]int)
+	}
+	pbs.inclusions[fullFrom][to]++
+}
+
+func (pbs *pbstate) renderMissingNode(name OriginalName, unique UniqueName, fullname FullName) string {
+
+	writer := bytes.NewBufferString("")
+	payload := EnumPayload{
+		Name:     string(name),
+		Unique:   unique,
+		FullName: fullname,
+	}
+	if err := pbs.templates().ApplyTemplate("missing.node", writer, payload); err != nil {
+		pbs.fail(&RenderError{Template: "missing.node", Err: err})
+		return ""
+	}
+
+	return writer.String()
+}
+
+// all the references to the same unresolved name share the node
+func missingName(missingType OriginalName) FullName {
+	return FullName("missing." + string(missingType))
+}
+
+func (pbs *pbstate) recordMissingType(missingType OriginalName) UniqueName {
+
+	fulltype := missingName(missingType)
+	if info, found := pbs.types237[fulltype]; !found {
+		unique := pbs.getUniqueName(missingType, fulltype)
+		pbs.types237[fulltype] = tinfo{
+			typename:  typenameMissing,
+			fullname:  fulltype,
+			unique:    unique,
+			name:      string(missingType),
+			protopack: pbs.proto,
+			raw:       pbs.renderMissingNode(OriginalName(missingType), unique, fulltype),
+			rows:      []row{{cells: []string{"this type is missing"}, color: "missing.background", span: true}},
+		}
+		return unique
+	} else {
+		return info.unique
+	}
+}
+
+func (pbs *pbstate) recordMissingInclusion(from UniqueName, field string, missingType OriginalName) {
+	pbs.debug("****** Field [", field, "] from [", from, "] refers to non-existing type [", missingType, "] ******")
+	if info := pbs.unique2info(from); info != nil {
+		pbs.unresolved[info.filename] = true
+	}
+
+	if pbs.session.Option("show missing types") {
+		// 1. save type (if not already)
+		unique := pbs.recordMissingType(missingType)
+
+		// 2. record the connection
+		pbs.recordInclusion(from, field, unique)
+	}
+}
+
+func (pbs *pbstate) getInclusion(from UniqueName, field string) (UniqueName, map[UniqueName]int) {
+
+	fullFrom := from
+	if len(field) > 0 {
+		fullFrom += UniqueName(":" + field)
+	}
+	if inc, found := pbs.inclusions[fullFrom]; found {
+		return fullFrom, inc
+	}
+	return "", nil
+}
+
+// the templates of the requested format (see Session.Format)
+func (pbs *pbstate) templates() *plus.Templates {
+	return pbs.session.templatesFor(pbs.format)
+}
+
+func (pbs *pbstate) applyTemplate(name string, payload interface{}) {
+	if err := pbs.templates().ApplyTemplate(name, pbs.target(), payload); err != nil {
+		pbs.fail(&RenderError{Template: name, Err: err})
+	}
+}
+
+func (pbs *pbstate) showSelectedInclusion(selection string) {
+	// pbs.types237
+	// pbs.inclusions
+	pbs.status("limiting output to the following: ", selection)
+	matches, err := pbs.expandSelection(selection)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+
+	// create new storage for the selections and their dependants
+	types := make(map[FullName]tinfo)
+	posttypes := make(map[FullName]tinfo)
+	inclusions := make(map[UniqueName]map[UniqueName]int)
+
+	for index, _ := range matches {
+		info := pbs.types237[matches[index]]
+
+		if len(info.parent) > 0 {
+			parentInfo := pbs.types237[info.parent] // types237   map[FullName]tinfo
+			pbs.debug("", parentInfo)
+		}
+
+		pbs.debug("type of the selection:", info.typename)
+		switch info.typename {
+		case typenameService:
+			// just works =)
+			pbs.debug("------", info)
+		case typenameRPC:
+			// this is a bit elaborate
+			// service
+			//   ...
+			//   rpc -> request, response
+			//   ...
+			rpc, ok := info.object.(*proto.RPC)
+			if ok {
+				parentType := info.parent
+				requestType := rpc.RequestType
+				returnsType := rpc.ReturnsType
+
+				// add 'parent' directly without all of its children
+				posttypes[parentType] = pbs.types237[parentType]
+
+				// add connections from 'parent' too children
+				for _, suffix := range []string{"_request", "_response"} {
+					from, to := pbs.getInclusion(pbs.types237[parentType].unique, rpc.Name+suffix)
+					if to != nil {
+						inclusions[from] = to
+					}
+				}
+
+				for _, one := range []string{requestType, returnsType} {
+					if inf := pbs.getResolution(parentType, OriginalName(one)); inf != nil {
+						matches = append(matches, inf.fullname)
+					} else {
+						// todo: react here? maybe?
+					}
+				}
+			} else {
+				pbs.fail(errors.New("failed to get an expected type for [" + string(info.fullname) + "]"))
+				return
+			}
+
+		case typenameMessage:
+			// nothing special here to do
+			pbs.debug("------", info)
+		default:
+			pbs.alert("entry of type [", info.typename, "] is not yet supported.")
+		}
+	}
+
+	depth := make(map[FullName]int) // distance from the selection
+	var collapsed []FullName
+	for len(matches) > 0 {
+		candidate := matches[0]
+		matches = matches[1:]
+		pbs.trace("---------------------------- considering: ", candidate)
+
+		if _, found := types[candidate]; found {
+			pbs.trace("          already added:", candidate)
+			continue
+		}
+		if pbs.isExcluded(candidate) {
+			pbs.trace("          excluded:", candidate)
+			continue
+		}
+		types[candidate] = pbs.types237[candidate]
+		unique := types[candidate].unique + ":"
+
+		if pbs.depth > 0 && depth[candidate] > pbs.depth {
+			// beyond the limit: the dependencies of the candidate are not shown
+			collapsed = append(collapsed, candidate)
+			continue
+		}
+
+		for key, value := range pbs.inclusions {
+			if strings.HasPrefix(string(key), string(unique)) {
+				pbs.trace("          checking [", key, "]")
+				for child, _ := range value {
+					if fullchild, found := pbs.knownNames[child]; found {
+						if _, found := types[fullchild]; !found {
+							// we have not seen this type before
+							if _, found := depth[fullchild]; !found {
+								depth[fullchild] = depth[candidate] + 1
+							}
+							matches = append(matches, fullchild)
+							pbs.trace("              adding [", child, "] [", value, "]")
+						} else {
+							pbs.trace("              already included [", fullchild, "]")
+						}
+						inclusions[key] = value
+					} else {
+						pbs.trace("              failed to find [", child, "]")
+					}
+				}
+			} else {
+				pbs.trace("          excluding [", key, "] cause it has no prefix [", unique, "]")
+			}
+		}
+	}
+
+	// copy posttypes to types237
+	for k, v := range posttypes {
+		types[k] = v
+	}
+
+	references := pbs.references() // the same for every collapsed type
+	for _, full := range collapsed {
+		if hidden := pbs.hiddenDependencies(full, types, references); hidden > 0 {
+			types[full] = pbs.collapse(types[full], hidden)
+		} else {
+			// everything it depends on is shown anyway
+			for key, value := range pbs.inclusions {
+				if strings.HasPrefix(string(key), string(types[full].unique)+":") {
+					inclusions[key] = value
+				}
+			}
+		}
+	}
+
+	{
+		tmp := make([]string, 0, len(types))
+		for _, info := range types {
+			tmp = append(tmp, info.name)
+		}
+		pbs.trace("for your selections found the following dependencies:", tmp)
+	}
+
+	backupTypes, backupInclusions := pbs.types237, pbs.inclusions
+	pbs.types237, pbs.inclusions = types, inclusions
+	pbs.showInclusion(false, true)
+	pbs.types237, pbs.inclusions = backupTypes, backupInclusions
+}
+
+// '-select <.Money': the selected types and everything (messages, services, extends) that depends on them, directly or not
+func (pbs *pbstate) showSelectedUsers(selection string) {
+	pbs.status("limiting output to the users of the following: ", selection)
+	matches, err := pbs.expandSelection(selection)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+
+	users := make(map[FullName][]FullName) // type -> the types referring to it
+	for from, tos := range pbs.references() {
+		for _, to := range tos {
+			users[to] = append(users[to], from)
+		}
+	}
+
+	types := make(map[FullName]tinfo)
+	for len(matches) > 0 {
+		candidate := matches[0]
+		matches = matches[1:]
+		if _, found := types[candidate]; found {
+			continue
+		}
+		pbs.trace("---------------------------- used by: ", candidate, users[candidate])
+		types[candidate] = pbs.types237[candidate]
+		matches = append(matches, users[candidate]...)
+	}
+
+	// only the connections between the shown types
+	inclusions := make(map[UniqueName]map[UniqueName]int)
+	for key, value := range pbs.inclusions {
+		if _, found := types[pbs.knownNames[UniqueName(strings.Split(string(key), ":")[0])]]; !found {
+			continue
+		}
+		for child, count := range value {
+			if _, found := types[pbs.knownNames[child]]; found {
+				if _, there := inclusions[key]; !there {
+					inclusions[key] = make(map[UniqueName]int)
+				}
+				inclusions[key][child] = count
+			}
+		}
+	}
+
+	backupTypes, backupInclusions := pbs.types237, pbs.inclusions
+	pbs.types237, pbs.inclusions = types, inclusions
+	pbs.showInclusion(false, true)
+	pbs.types237, pbs.inclusions = backupTypes, backupInclusions
+}
+
+func (pbs *pbstate) showInclusion(groupByPackages bool, leaveRootPackageUnwrapped bool) {
+	if len(pbs.exclusions) > 0 {
+		backupTypes, backupInclusions := pbs.types237, pbs.inclusions
+		pbs.types237, pbs.inclusions = pbs.withoutExcluded()
+		defer func() { pbs.types237, pbs.inclusions = backupTypes, backupInclusions }()
+	}
+
+	switch pbs.format {
+	case FormatMermaid:
+		pbs.showMermaid()
+		return
+	case FormatJSON:
+		pbs.showJSON(true)
+		return
+	}
+
+	payload := PBS{
+		Package:    pbs.pkg,
+		Protoname:  pbs.proto,
+		AppVersion: appVersion,
+		Timestamp:  time.Now().Format(time.RFC850),
+		Selection:  pbs.selection,
+		Options:    "",
+	}
+
+	pbs.applyTemplate("document.header", payload)
+	pbs.applyTemplate("comment", "nodes")
+
+	if groupByPackages {
+		groups := make(map[string][]tinfo)
+		for _, info := range pbs.types237 {
+			if _, present := groups[info.protopack]; !present {
+				groups[info.protopack] = make([]tinfo, 0)
+			}
+			groups[info.protopack] = append(groups[info.protopack], info)
+		}
+
+		for group, members := range groups {
+			components := strings.Split(group, string(os.PathSeparator))
+
+			data := Cluster{
+				ProtoName:       strings.Replace(group, "\\", "\\\\", -1),
+				ProtoNameKosher: support.NameToId(group, 12),
+				ShortName:       components[len(components)-1],
+			}
+
+			if leaveRootPackageUnwrapped && pbs.isRoot(group) {
+
+				pbs.applyTemplate("comment", "leaving the root package unwrapped")
+				pbs.writeEntries(members, "entry")
+			} else {
+
+				pbs.applyTemplate("cluster.prefix", data)
+				pbs.writeEntries(members, "cluster.entry")
+				pbs.applyTemplate("cluster.suffix", data)
+			}
+		}
+	} else {
+		members := make([]tinfo, 0, len(pbs.types237))
+		for _, info := range pbs.types237 {
+			members = append(members, info)
+		}
+		pbs.writeEntries(members, "entry")
+	}
+
+	if pbs.unused != nil && pbs.session.Option(highlightUnused) {
+		for _, full := range pbs.unused.Types {
+			if info, found := pbs.types237[full]; found {
+				pbs.applyTemplate("unused.node", EnumPayload{Name: info.name, Unique: info.unique, FullName: info.fullname})
+			}
+		}
+	}
+
+	pbs.applyTemplate("comment", "connections")
+
+	var toTemplateName = map[string]string{
+		typenameEnum:    "from.to.enum",
+		typenameMessage: "from.to.message",
+		typenameMissing: "from.to.missing",
+	}
+
+	// from, field, to
+	for from, tos := range pbs.inclusions {
+		for to, _ := range tos {
+
+			bits := strings.Split(string(from), ":")
+			args := Relationship{
+				From:   bits[0],
+				To:     to, // UniqueName
+				ToName: "", // todo: fill these up later
+				ToType: "", // FullName
+			}
+
+			if len(bits) > 1 {
+				args.Field = bits[1]
+			}
+
+			tmplName := toTemplateName[pbs.types237[pbs.knownNames[to]].typename]
+			if args.Field == extendeeField && pbs.types237[pbs.knownNames[UniqueName(args.From)]].typename == typenameExtend {
+				tmplName = "from.to.extend"
+			}
+			pbs.applyTemplate(tmplName, args)
+			// pbs.applyTemplate(isMessage[pbs.uniqueIsMessage(to)], args)
+		}
+	}
+
+	if pbs.session.Option(showContainment) {
+		for _, args := range pbs.containments() {
+			pbs.applyTemplate("from.to.nested", args)
+		}
+	}
+
+	pbs.applyTemplate("document.footer", payload)
+	pbs.graph = pbs.inclusionGraph()
+}
+
+func (pbs *pbstate) uniqueIsMessage(unique UniqueName) bool {
+	if full, found := pbs.knownNames[unique]; found {
+		if info, found := pbs.types237[full]; found {
+			if info.typename == typenameMessage {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (pbs *pbstate) handleSyntax(syntax *proto.Syntax) {
+	pbs.trace("\tsyntax:", syntax.Value)
+
+	pbs.currentPkgInfo().proto3 = (syntax.Value == "proto3")
+}
+
+func (pbs *pbstate) handleImport(imp *proto.Import) {
+	pbs.trace("\timport:", imp.Filename)
+
+	if pbs.dive {
+		prev, prev_pkg := pbs.proto, pbs.pkg
+		file := imp.Filename
+		if root, found := pbs.importedRoot(file); found {
+			file = root
+		}
+		self := pbs.currentPkgInfo()
+		self.dependencies = append(self.dependencies, file)
+		switch imp.Kind {
+		case importPublic:
+			self.public = append(self.public, file)
+		case importWeak:
+			self.weak = append(self.weak, file)
+		}
+
+		pbs.diveDepth++
+		pbs.debug("-- leaving [", pbs.proto, "] and diving into", file)
+		if err := process(pbs, file, ""); err != nil {
+			pbs.fail(err)
+		}
+		pbs.diveDepth--
+		pbs.pkg, pbs.proto = prev_pkg, prev
+		// pbs.proto = prev
+		pbs.debug("-- back to [", pbs.proto, "]")
+	}
+}
+
+func (pbs *pbstate) handlePackageDeclaration(pkg *proto.Package) {
+	pbs.trace("\tpackage:", pkg.Name)
+	pbs.pkg = pkg.Name
+
+	pbs.currentPkgInfo().packageName = pkg.Name
+}
+
+func (pbs *pbstate) handleEnumDeclaration(e *proto.Enum) {
+
+	fullname, err := getFullName(e)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+	unique := pbs.getUniqueName(OriginalName(e.Name), fullname)
+
+	writer := bytes.NewBufferString("")
+
+	payload := EnumPayload{
+		Name:     e.Name,
+		Unique:   unique,
+		FullName: fullname,
+		Comment:  commentText(e.Comment),
+	}
+	if err := pbs.templates().ApplyTemplate("enum.prefix", writer, payload); err != nil {
+		pbs.fail(&RenderError{Template: "enum.prefix", Err: err})
+	}
+
+	var rows []row
+	for _, element := range e.Elements {
+		switch actual := element.(type) {
+		case *proto.EnumField:
+			payload.Name = actual.Name
+			payload.Value = strconv.Itoa(actual.Integer)
+			payload.Comment = commentText(actual.Comment, actual.InlineComment)
+			payload.Options, payload.Deprecated = enumFieldOptions(actual)
+			if err := pbs.templates().ApplyTemplate("enum.entry", writer, payload); err != nil {
+				pbs.fail(&RenderError{Template: "enum.entry", Err: err})
+			}
+			rows = append(rows, row{cells: []string{payload.Name, payload.Value}, title: payload.Comment, deprecated: payload.Deprecated})
+		case *proto.Option:
+			ignoring("ignoring options for now")
+		case *proto.Comment:
+			// the comments attached to the values are taken care of above
+		case *proto.Reserved:
+			ignoring("ignoring Reserved for now")
+		default:
+			rname := reflect.TypeOf(actual).Elem().Name()
+			unhandled("\t", "UNKNOWN2", actual, "", rname)
+		}
+	}
+
+	payload.Name, payload.Value, payload.Comment = e.Name, "", commentText(e.Comment)
+	payload.Options, payload.Deprecated = nil, false
+	if err := pbs.templates().ApplyTemplate("enum.suffix", writer, payload); err != nil {
+		pbs.fail(&RenderError{Template: "enum.suffix", Err: err})
+	}
+
+	pbs.types237[fullname] = tinfo{
+		typename:  typenameEnum,
+		fullname:  fullname,
+		unique:    unique,
+		name:      e.Name,
+		filename:  e.Position.Filename,
+		raw:       writer.String(),
+		rows:      rows,
+		doc:       payload.Comment,
+		protopack: pbs.pkg,
+		parent:    enclosingType(e.Parent),
+		object:    e,
+	}
+}
+
+func (pbs *pbstate) dbgPrintKnownResolutions(fullname FullName) {
+	pbs.debug("-------------------------------------- all known resolutions for:", fullname)
+	if all, found := pbs.resolutions[fullname]; found { // map[FullName]map[OriginalName]FullName
+		for k, v := range all {
+			pbs.debug("      ", k, " ---> ", v)
+		}
+	}
+	pbs.debug("--------------------------------------")
+}
+
+var typename2kind = map[string]Kind{
+	typenameEnum:    Enum,
+	typenameMessage: Message,
+	typenameMissing: Missing,
+}
+
+func (pbs *pbstate) getKind(fullname FullName, what OriginalName) Kind {
+
+	if isSimpleType(string(what)) {
+		return Simple
+	}
+
+	if info := pbs.getResolution(fullname, what); info != nil {
+		if pbs.isExcluded(info.fullname) {
+			// shown inline, as the simple types are
+			return Simple
+		}
+		if kind, found := typename2kind[info.typename]; found {
+			return kind
+		}
+
+		pbs.fail(errors.New("unknown typename [" + info.typename + "] found while resolving type: " + string(what)))
+		return Unknown
+	}
+
+	pbs.dbgPrintKnownResolutions(fullname)
+
+	pbs.fail(&UnresolvedTypeError{Type: what, Scope: fullname})
+	return Unknown
+}
+
+func getPackageName(pro *proto.Proto) string {
+
+	for _, element := range pro.Elements {
+		switch actual := element.(type) {
+		case *proto.Package:
+			return actual.Name
+		}
+	}
+	// declared without a package
+	return ""
+}
+
+const separator string = "."
+
+func getParent(what proto.Visitee) string {
+	cmd := ""
+	switch parent := what.(type) {
+	case *proto.Proto:
+		cmd = getPackageName(parent)
+
+	case *proto.Message:
+		full, _ := getFullName(parent) // the message declared in another message scope
+		cmd = string(full)
+
+	case *proto.Group:
+		cmd = getParent(parent.Parent) + separator + parent.Name // the message declared in the group scope
+
+	case *proto.Oneof:
+		cmd = getParent(parent.Parent) // oneof does not introduce a scope
+
+	default:
+		rname := reflect.TypeOf(parent).Elem().Name()
+		unhandled("\t", "UNKNOWN3", parent, "", rname)
+	}
+	return cmd
+}
+
+func getFullName(what interface{}) (FullName, error) {
+	switch actual := what.(type) {
+	case *proto.Message:
+		if actual.IsExtend {
+			// there can be more than one 'extend' of the same message in the same scope
+			return FullName(fmt.Sprintf("%s%sextend:%s:%d", getParent(actual.Parent), separator, actual.Name, actual.Position.Line)), nil
+		}
+		return FullName(getParent(actual.Parent) + separator + actual.Name), nil
+	case *proto.Group:
+		return FullName(getParent(actual.Parent) + separator + actual.Name), nil
+	case *proto.Enum:
+		return FullName(getParent(actual.Parent) + separator + actual.Name), nil
+	case *proto.Service:
+		return FullName(getParent(actual.Parent) + separator + actual.Name), nil
+	default:
+		return "", fmt.Errorf("getting full name of %T is not yet supported", what)
+	}
+}
+
+func (pbs *pbstate) handleMessageDeclaration(msg *proto.Message) {
+
+	parent := getParent(msg.Parent)
+	fullname, err := getFullName(msg)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+	unique := pbs.getUniqueName(OriginalName(msg.Name), fullname)
+
+	typename := typenameMessage
+	if msg.IsExtend {
+		// 'extend' does not declare a type anyone can refer to
+		typename = typenameExtend
+		pbs.recordExtensions(msg)
+	}
+
+	pbs.debug("*** type definition:", pbs.pkg, ">>", msg.Name, ">>", parent, ">>>>>>>>", fullname)
+
+	pbs.types237[fullname] = tinfo{
+		typename: typename,
+		fullname: fullname,
+		unique:   unique,
+		name:     msg.Name,
+
+		filename:  msg.Position.Filename,
+		comment:   parent,
+		protopack: pbs.proto,
+		parent:    enclosingType(msg.Parent),
+		object:    msg,
+	}
+}
+
+func (pbs *pbstate) resolveType(full FullName, local OriginalName) {
+
+	if isSimpleType(string(local)) {
+		// no need to resolve simple types237
+		return
+	}
+
+	if _, found := pbs.resolutions[full][local]; found {
+		// looks like we already know what 'local' type maps to
+		return
+	}
+
+	if found, ok := pbs.lookupType(full, local, pbs.visibleFiles(pbs.proto)); ok {
+		pbs.trace("", full, ", mapping ", local, " to ", found)
+		pbs.addResolution(full, local, found)
+	} else {
+		pbs.alert("failed to resolve type:", local, "; scope:", full)
+	}
+}
+
+func (pbs *pbstate) handleMessageTypeResolution(msg *proto.Message) {
+
+	fullname, err := getFullName(msg)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+
+	if msg.IsExtend {
+		pbs.resolveType(fullname, OriginalName(msg.Name))
+	}
+
+	for _, element := range msg.Elements {
+		switch actual := element.(type) {
+		case *proto.Oneof:
+			for _, element := range actual.Elements {
+				switch fact := element.(type) {
+				case *proto.OneOfField:
+					if !isSimpleType(fact.Type) {
+						pbs.resolveType(fullname, OriginalName(fact.Type))
+					}
+				case *proto.Group:
+					pbs.resolveType(fullname, OriginalName(fact.Name))
+				}
+			}
+
+		case *proto.Group:
+			pbs.resolveType(fullname, OriginalName(actual.Name))
+
+		case *proto.NormalField:
+			pbs.resolveType(fullname, OriginalName(actual.Type))
+
+		case *proto.MapField:
+			pbs.resolveType(fullname, OriginalName(actual.Type))
+		}
+	}
+}
+
+func (pbs *pbstate) handleServiceTypeResolution(srv *proto.Service) {
+
+	fullname, err := getFullName(srv)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+	for _, element := range srv.Elements {
+		switch actual := element.(type) {
+		case *proto.RPC:
+			pbs.resolveType(fullname, OriginalName(actual.RequestType))
+			pbs.resolveType(fullname, OriginalName(actual.ReturnsType))
+		}
+	}
+}
+
+var isRepeated = map[bool]string{
+	false: "",
+	true:  "[...]",
+}
+
+func (pbs *pbstate) handleMessageBody(msg *proto.Message) {
+
+	full, err := getFullName(msg)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+	info := pbs.types237[full]
+
+	message := msg.Name
+	pbs.debug("message", msg.Name, "-------------------------------------")
+
+	prefix := "message.prefix"
+	if msg.IsExtend {
+		prefix = "extend.prefix"
+		if inf := pbs.getResolution(full, OriginalName(msg.Name)); inf != nil {
+			pbs.recordInclusion(info.unique, extendeeField, inf.unique)
+		} else {
+			pbs.alert("failed to resolve extended type [", msg.Name, "] from ", full)
+			pbs.recordMissingInclusion(info.unique, extendeeField, OriginalName(msg.Name))
+		}
+	}
+	t := newTable(pbs.templates(), prefix, message, info.fullname, info.unique, commentText(msg.Comment))
+
+	for _, element := range msg.Elements {
+		switch actual := element.(type) {
+		case *proto.NormalField:
+			if !isSimpleType(actual.Type) {
+				if inf := pbs.getResolution(full, OriginalName(actual.Type)); inf != nil {
+					pbs.encounteredType(info.unique, actual.Name, inf.unique)
+				} else {
+					pbs.alert("failed to resolve", actual.Type)
+					pbs.recordMissingInclusion(info.unique, actual.Name, OriginalName(actual.Type))
+				}
+			}
+			if pbs.droppedField(full, actual.Type) {
+				// recorded (see above), but not shown
+				continue
+			}
+
+			repeated := isRepeated[actual.Repeated]
+			t.addRow(repeated, actual.Type, actual.Name, strconv.Itoa(actual.Sequence), commentText(actual.Comment, actual.InlineComment), actual.Options, pbs.getKind(full, OriginalName(actual.Type)))
+			break
+
+		case *proto.Enum:
+			pbs.debug("\t", "enum:", actual.Name)
+		case *proto.Reserved:
+			pbs.debug("\t", "reserved:", actual.FieldNames)
+			t.addReserved(actual)
+		case *proto.Option:
+			pbs.debug("\t", "option:", actual.Name)
+		case *proto.Message:
+			pbs.debug("\t", "message:", actual.Name)
+		case *proto.Oneof:
+			pbs.onOneof(full, info.unique, actual)
+			t.addOneof(full, actual, pbs)
+		case *proto.MapField:
+			pbs.debug("\t", "map-field:", actual.Name, ",   map<", actual.KeyType, ", ", actual.Type, ">")
+			if !isSimpleType(actual.Type) {
+				if inf := pbs.getResolution(full, OriginalName(actual.Type)); inf != nil {
+					pbs.recordInclusion(info.unique, actual.Name, inf.unique)
+				} else {
+					pbs.alert("failed to resolve type [", actual.Type, "] from ", full)
+					pbs.recordMissingInclusion(info.unique, actual.Name, OriginalName(actual.Type))
+				}
+			}
+			if pbs.droppedField(full, actual.Type) {
+				continue
+			}
+			// Q: can map be 'repeated' ?
+			t.addMapRow(actual.Name, actual.KeyType, actual.Type, strconv.Itoa(actual.Sequence), commentText(actual.Comment, actual.InlineComment), actual.Options, pbs.getKind(full, OriginalName(actual.Type)))
+
+		case *proto.Comment:
+			// the comments attached to the fields are taken care of by the fields
+			pbs.debug("\t", "comment:", actual.Message())
+
+		case *proto.Extensions:
+			t.addExtensions(rangesText(actual.Ranges), commentText(actual.Comment, actual.InlineComment))
+
+		case *proto.Group:
+			field := groupField(actual)
+			if inf := pbs.getResolution(full, OriginalName(actual.Name)); inf != nil {
+				pbs.encounteredType(info.unique, field, inf.unique)
+			} else {
+				pbs.alert("failed to resolve group", actual.Name)
+				pbs.recordMissingInclusion(info.unique, field, OriginalName(actual.Name))
+			}
+			if pbs.droppedField(full, actual.Name) {
+				continue
+			}
+			t.addRow(isRepeated[actual.Repeated], actual.Name, field, strconv.Itoa(actual.Sequence), commentText(actual.Comment), nil, pbs.getKind(full, OriginalName(actual.Name)))
+
+		default:
+			rname := reflect.TypeOf(actual).Elem().Name()
+			unhandled("\t", "UNKNOWN4", actual, "", rname)
+		}
+	}
+
+	info.raw = t.generate()
+	if t.err != nil {
+		pbs.fail(t.err)
+	}
+	info.rows = t.rows
+	info.doc = commentText(msg.Comment)
+	pbs.types237[full] = info
+}
+
+func (pbs *pbstate) onOneof(fullname FullName, unique UniqueName, one *proto.Oneof) {
+	pbs.debug("oneof", one.Name)
+	if len(one.Elements) > 0 {
+		for _, element := range one.Elements {
+			switch actual := element.(type) {
+			case *proto.OneOfField:
+				pbs.debug("\t", "one-of-field:", actual.Name, ", type:", actual.Type)
+
+				if !isSimpleType(actual.Type) {
+					if inf := pbs.getResolution(fullname, OriginalName(actual.Type)); inf != nil {
+						pbs.encounteredType(unique, actual.Name, inf.unique)
+					} else {
+						pbs.alert("failed to get unique name for type", actual.Type)
+						pbs.recordMissingInclusion(unique, actual.Name, OriginalName(actual.Type))
+					}
+				}
+
+			case *proto.Option:
+				ignoring("ignoring options for now")
+
+			case *proto.Comment:
+				pbs.debug("\t", "comment:", actual.Message()) // the comments attached to the fields are taken care of by addOneof
+
+			case *proto.Group:
+				field := groupField(actual)
+				if inf := pbs.getResolution(fullname, OriginalName(actual.Name)); inf != nil {
+					pbs.encounteredType(unique, field, inf.unique)
+				} else {
+					pbs.alert("failed to get unique name for group", actual.Name)
+					pbs.recordMissingInclusion(unique, field, OriginalName(actual.Name))
+				}
+
+			default:
+				rname := reflect.TypeOf(actual).Elem().Name()
+				unhandled("\t", "UNKNOWN5", actual, "", rname)
+			}
+		}
+	}
+}
+
+func (pbs *pbstate) handleOption(opt *proto.Option) {
+	value := opt.Constant.Source
+	pbs.debug("\t\t", "option", opt.Name, ":", value)
+
+	for _, one := range opt.AggregatedConstants {
+		pbs.debug("\t", "\t", "constant:", one.Name, ">>>", one.Literal.Source)
+	}
+}
+
+var isStreaming = map[bool]string{
+	false: "",
+	true:  "stream",
+}
+
+func (pbs *pbstate) handleServiceDeclaration(srv *proto.Service) {
+
+	name, err := getFullName(srv)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+	srvUniqueName := pbs.getUniqueName(OriginalName(srv.Name), name)
+
+	cmd := ""
+	switch parent := srv.Parent.(type) {
+	case *proto.Proto:
+		cmd = parent.Filename // the message declared in the file scope
+	case *proto.Message:
+		cmd = parent.Name // the message declared in another message scope
+	default:
+		rname := reflect.TypeOf(parent).Elem().Name()
+		unhandled("\t", "UNKNOWN6", parent, "", rname)
+	}
+
+	writer := bytes.NewBufferString("")
+	payload := ServicePayload{
+		Name:     srv.Name,
+		Unique:   srvUniqueName,
+		FullName: name,
+		Comment:  commentText(srv.Comment),
+	}
+	if err := pbs.templates().ApplyTemplate("service.prefix", writer, payload); err != nil {
+		pbs.fail(&RenderError{Template: "service.prefix", Err: err})
+	}
+
+	var rows []row
+	for _, element := range srv.Elements {
+		switch actual := element.(type) {
+		case *proto.RPC:
+			fullname := name + FullName("."+actual.Name)
+
+			pbs.types237[fullname] = tinfo{
+				typename:  typenameRPC,
+				fullname:  fullname,
+				unique:    pbs.getUniqueName(OriginalName(actual.Name), fullname),
+				name:      actual.Name,
+				filename:  srv.Position.Filename,
+				comment:   cmd,
+				protopack: pbs.proto,
+				parent:    name,
+				object:    actual,
+			}
+
+			payload := RPC{
+				Name:           actual.Name,
+				RequestType:    actual.RequestType,
+				ReturnsType:    actual.ReturnsType,
+				StreamsRequest: isStreaming[actual.StreamsRequest],
+				StreamsReturns: isStreaming[actual.StreamsReturns],
+				Comment:        commentText(actual.Comment, actual.InlineComment),
+			}
+			if err := pbs.templates().ApplyTemplate("service.rpc", writer, payload); err != nil {
+				pbs.fail(&RenderError{Template: "service.rpc", Err: err})
+			}
+			rows = append(rows,
+				row{cells: []string{actual.Name, payload.StreamsRequest, actual.RequestType}, port: actual.Name + "_request", title: payload.Comment},
+				row{cells: []string{"", payload.StreamsReturns, actual.ReturnsType}, port: actual.Name + "_response", color: "service.return"})
+		default:
+			// unhandled("UNKNOWN21")
+		}
+	}
+
+	if err := pbs.templates().ApplyTemplate("service.suffix", writer, payload); err != nil {
+		pbs.fail(&RenderError{Template: "service.suffix", Err: err})
+	}
+
+	pbs.types237[name] = tinfo{
+		typename:  typenameService,
+		fullname:  name,
+		unique:    srvUniqueName,
+		name:      srv.Name,
+		filename:  srv.Position.Filename,
+		comment:   cmd,
+		protopack: pbs.proto,
+		raw:       writer.String(),
+		rows:      rows,
+		doc:       payload.Comment,
+		object:    srv,
+	}
+}
+
+func (pbs *pbstate) handleServiceBody(srv *proto.Service) {
+	pbs.trace("Service", srv.Name)
+
+	full, err := getFullName(srv)
+	if err != nil {
+		pbs.fail(err)
+		return
+	}
+	info := pbs.full2info(full)
+
+	for _, element := range srv.Elements {
+		switch actual := element.(type) {
+		case *proto.RPC:
+			pbs.trace("\tMethod", actual.Name, "(", actual.RequestType, ")", actual.ReturnsType)
+			payload := RPC{
+				Name:           actual.Name,
+				RequestType:    actual.RequestType,
+				ReturnsType:    actual.ReturnsType,
+				StreamsRequest: isStreaming[actual.StreamsRequest],
+				StreamsReturns: isStreaming[actual.StreamsReturns],
+			}
+			_ = payload
+
+			// request
+			if !isSimpleType(actual.RequestType) {
+				field := actual.Name + "_request"
+				if inf := pbs.getResolution(full, OriginalName(actual.RequestType)); inf != nil {
+					pbs.recordInclusion(info.unique, field, inf.unique)
+				} else {
+					pbs.alert("failed to resolve type [", actual.RequestType, "] from ", full)
+					pbs.recordMissingInclusion(info.unique, field, OriginalName(actual.RequestType))
+				}
+			}
+
+			// response
+			if !isSimpleType(actual.ReturnsType) {
+				field := actual.Name + "_response"
+				if inf := pbs.getResolution(full, OriginalName(actual.ReturnsType)); inf != nil {
+					pbs.recordInclusion(info.unique, field, inf.unique)
+				} else {
+					pbs.alert("failed to resolve type [", actual.ReturnsType, "] from ", full)
+					pbs.recordMissingInclusion(info.unique, field, OriginalName(actual.ReturnsType))
+				}
+			}
+
+		case *proto.Option:
+			ignoring("ignoring options for now")
+
+		case *proto.Comment:
+			pbs.debug("\t", "comment:", actual.Message()) // the comments attached to the rpcs are taken care of by handleServiceDeclaration
+
+		default:
+			rname := reflect.TypeOf(actual).Elem().Name()
+			unhandled("\t", "UNKNOWN7", actual, "", rname)
+		}
+	}
+}
+
+func (pbs *pbstate) encounteredType(parent UniqueName, field string, typ UniqueName) {
+	pbs.recordInclusion(parent, field, typ)
+}
+
+var import2template = map[bool]string{
+	false: "imports.node",
+	true:  "imports.node.missing",
+}
+
+func (pbs *pbstate) showDependencyTree() {
+	if pbs.diveDepth != 0 {
+		pbs.fail(errors.New("import dependency tree can only be shown from the root"))
+		return
+	}
+
+	getID := func(name string) string {
+		return "N" + support.NameToId(name, 16)
+	}
+
+	correctRootFileName := func(name string) string {
+		if pbs.isRoot(name) {
+			parts := strings.Split(strings.Replace(name, "\\", "/", -1), "/")
+			return parts[len(parts)-1]
+		}
+		return name
+	}
+
+	switch pbs.format {
+	case FormatMermaid:
+		pbs.showMermaidImports(getID, correctRootFileName)
+		return
+	case FormatJSON:
+		pbs.showJSON(false)
+		return
+	}
+
+	payload := PBS{
+		Package:    pbs.pkg,
+		Protoname:  pbs.proto,
+		AppVersion: appVersion,
+		Timestamp:  time.Now().Format(time.RFC850),
+		Selection:  "(imports dependency)",
+		Options:    "",
+	}
+
+	pbs.applyTemplate("imports.header", payload)
+
+	pbs.applyTemplate("comment", "nodes")
+	for name, info := range pbs.knownFiles {
+		payload := ImportNode{
+			NodeName:    getID(name),
+			PackageName: info.packageName,
+			FileName:    correctRootFileName(info.fileName),
+			Status:      "",
+		}
+		pbs.applyTemplate(import2template[info.missing], payload)
+		_ = info
+	}
+
+	pbs.applyTemplate("comment", "connections")
+	inCycle := cycleEdges(pbs.cycles)
+	for name, info := range pbs.knownFiles {
+		payload := ImportLink{
+			From: getID(name),
+		}
+		for _, toname := range info.dependencies {
+			payload.To = getID(toname)
+			payload.Kind = info.importKind(toname)
+			payload.Cycle = inCycle[name+">"+toname]
+			pbs.applyTemplate("imports.connection", payload)
+		}
+	}
+
+	pbs.applyTemplate("imports.footer", payload)
+	pbs.graph = pbs.dependencyGraph(getID, correctRootFileName)
+}
+
+// returns the errors related to the given file (e.g. missing, failed to parse) and,
+// when called for the root file, the first error encountered while processing any of the files
+func process(pbs *pbstate, name string, selection string) error {
+	if pbs.diveDepth == 0 {
+		return processRoots(pbs, []string{name}, selection)
+	}
+	_, err := pbs.load(name)
+	return err
+}
+
+// parses the given file (and, diving, the ones it imports) into pbs.
+// returns the name the file is known under (see knownFiles)
+func (pbs *pbstate) load(name string) (string, error) {
+
+	original := name
+	if len(pbs.incMapping) > 0 {
+		if replace, found := pbs.incMapping[name]; found {
+			pbs.trace("replacing [", name, "] with [", replace, "]")
+			name = replace
+		}
+	}
+
+	var reader io.Reader = nil
+	// need to differenciate between url/path and actual source
+	if strings.Count(name, "\n") > 1 {
+		pbs.trace("this seems to be a source code blob")
+		reader = strings.NewReader(name)
+
+		if name == original {
+			// it appears that we're given the blob directly
+			original = "blob_" + support.Hash([]byte(name))
+		}
+
+	} else if strings.HasSuffix(strings.ToLower(name), ".proto") {
+		//
+
+	} else {
+		return original, errors.New("undetected type of input: " + name)
+	}
+
+	if _, found := pbs.knownFiles[original]; found {
+		// we already dealt with this one (or are still dealing with it: see importCycles)
+		pbs.debug("already known:", original)
+		return original, nil
+	}
+
+	var definition *proto.Proto
+	if reader != nil {
+		var err error
+		if definition, err = parse(reader, original); err != nil {
+			return original, err
+		}
+	} else {
+		location, err := pbs.session.resolve(name, pbs.rootDir)
+		if err != nil {
+			if pbs.diveDepth > 0 && pbs.session.Option("allow missing imports") {
+				// failed to find/open an import, but since this is not a main file and we're allowed to continue: do so
+
+				// remember the fact that this .proto is missing
+				pbs.knownFiles[original] = &pkgInfo{
+					fileName: original,
+					missing:  true,
+				}
+				return original, nil
+			}
+			return original, &MissingFileError{Name: name, Err: err}
+		}
+		if definition, err = pbs.session.parseFile(location, original, !pbs.inMemory); err != nil {
+			return original, err
+		}
+	}
+
+	pbs.trace("\tprocessing file:", definition.Filename)
+	pbs.knownFiles[original] = &pkgInfo{
+		fileName:     original,
+		dependencies: make([]string, 0),
+	}
+	pbs.proto = original
+
+	proto.Walk(definition,
+		WithSyntax(pbs.handleSyntax),
+		WithImport(pbs.handleImport),
+		proto.WithEnum(pbs.handleEnumDeclaration),
+		proto.WithMessage(pbs.handleMessageDeclaration),
+		WithGroup(func(group *proto.Group) { pbs.handleMessageDeclaration(groupMessage(group)) }),
+		WithPackage(pbs.handlePackageDeclaration),
+		proto.WithOption(pbs.handleOption),
+		proto.WithService(pbs.handleServiceDeclaration),
+		pbs.handleOptionUses,
+	)
+
+	pbs.debug("------------ all known types237:")
+	for key, value := range pbs.types237 {
+		pbs.debug("---", key, "---", value)
+	}
+	pbs.debug("------------")
+
+	proto.Walk(definition,
+		proto.WithMessage(pbs.handleMessageTypeResolution),
+		WithGroup(func(group *proto.Group) { pbs.handleMessageTypeResolution(groupMessage(group)) }),
+		proto.WithService(pbs.handleServiceTypeResolution))
+
+	proto.Walk(definition,
+		proto.WithMessage(pbs.handleMessageBody),
+		WithGroup(func(group *proto.Group) { pbs.handleMessageBody(groupMessage(group)) }),
+		proto.WithService(pbs.handleServiceBody))
+
+	return original, nil
+}
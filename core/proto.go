@@ -0,0 +1,138 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"github.com/emicklei/proto"
+	"strings"
+)
+
+//----------------------------------------------------------------------------------------------------------------------
+// additions/extentions to "github.com/emicklei/proto"
+
+func WithPackage(apply func(*proto.Package)) proto.Handler {
+	return func(v proto.Visitee) {
+		if s, ok := v.(*proto.Package); ok {
+			apply(s)
+		}
+	}
+}
+
+func WithImport(apply func(*proto.Import)) proto.Handler {
+	return func(v proto.Visitee) {
+		if s, ok := v.(*proto.Import); ok {
+			apply(s)
+		}
+	}
+}
+
+func WithSyntax(apply func(*proto.Syntax)) proto.Handler {
+	return func(v proto.Visitee) {
+		if s, ok := v.(*proto.Syntax); ok {
+			apply(s)
+		}
+	}
+}
+
+func WithGroup(apply func(*proto.Group)) proto.Handler {
+	return func(v proto.Visitee) {
+		if s, ok := v.(*proto.Group); ok {
+			apply(s)
+		}
+	}
+}
+
+// (proto2) group is both a field and a message declaration: this is the declaration part
+func groupMessage(group *proto.Group) *proto.Message {
+	return &proto.Message{
+		Position: group.Position,
+		Comment:  group.Comment,
+		Name:     group.Name,
+		Elements: group.Elements,
+		Parent:   group.Parent,
+	}
+}
+
+// the name of the field, declared by the group
+func groupField(group *proto.Group) string {
+	return strings.ToLower(group.Name)
+}
+
+// e.g. "100 to 199, 1000 to max"
+func rangesText(ranges []proto.Range) string {
+	parts := make([]string, 0, len(ranges))
+	for _, one := range ranges {
+		parts = append(parts, one.SourceRepresentation())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// the text of the given (leading and/or inline) comments as a single line
+func commentText(comments ...*proto.Comment) string {
+	var parts []string
+	for _, comment := range comments {
+		if comment == nil {
+			continue
+		}
+		for _, line := range comment.Lines {
+			if line = strings.TrimSpace(line); len(line) > 0 {
+				parts = append(parts, line)
+			}
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// the value of the option as written in the source, e.g. "true", "\"name\"" or "{ min: 1, max: 10 }"
+func literalText(literal *proto.Literal) string {
+	if len(literal.OrderedMap) > 0 {
+		parts := make([]string, 0, len(literal.OrderedMap))
+		for _, one := range literal.OrderedMap {
+			parts = append(parts, one.Name+": "+literalText(one.Literal))
+		}
+		return "{ " + strings.Join(parts, ", ") + " }"
+	}
+	if len(literal.Array) > 0 {
+		parts := make([]string, 0, len(literal.Array))
+		for _, one := range literal.Array {
+			parts = append(parts, literalText(one))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	return literal.SourceRepresentation()
+}
+
+// the options of a field (or an enum value), and whether it is marked as deprecated
+func fieldOptions(options []*proto.Option) ([]FieldOption, bool) {
+	var result []FieldOption
+	deprecated := false
+	for _, one := range options {
+		option := FieldOption{Name: one.Name, Value: literalText(&one.Constant)}
+		if option.Name == "deprecated" && option.Value == "true" {
+			deprecated = true
+		}
+		result = append(result, option)
+	}
+	return result, deprecated
+}
+
+// the options of an enum value are kept among its elements
+func enumFieldOptions(field *proto.EnumField) ([]FieldOption, bool) {
+	var options []*proto.Option
+	for _, element := range field.Elements {
+		if option, ok := element.(*proto.Option); ok {
+			options = append(options, option)
+		}
+	}
+	return fieldOptions(options)
+}
+
+// e.g. "2, 15, 9 to 11" or "\"foo\", \"bar\""
+func reservedText(reserved *proto.Reserved) string {
+	if len(reserved.FieldNames) > 0 {
+		return "\"" + strings.Join(reserved.FieldNames, "\", \"") + "\""
+	}
+	return rangesText(reserved.Ranges)
+}
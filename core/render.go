@@ -0,0 +1,172 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package core parses .proto sources, resolves the types they refer to and renders the result
+// as a graphviz (.dot) diagram, e.g.:
+//
+//	dot, err := core.Render(ctx, "api.proto", core.Options{Config: config, Selection: ".CreateOrder"})
+//
+// the configuration has the same layout as protodot's config.json (see support.LoadConfig);
+// without one, the embedded config.json and templates (see package defaults) are used.
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/seamia/protodot/defaults"
+)
+
+// the output formats (see Options.Format)
+const (
+	FormatDot      = "dot"
+	FormatSvg      = "svg"
+	FormatPng      = "png"
+	FormatMermaid  = "mermaid"
+	FormatPlantUML = "plantuml"
+	FormatJSON     = "json"
+)
+
+// the formats produced directly (i.e. without 'graphviz' or the native renderer).
+// the template sets (see 'templates.<format>' config sections) not listed here get "." + format
+var format2extension = map[string]string{
+	FormatDot:      ".dot",
+	FormatMermaid:  ".mmd",
+	FormatPlantUML: ".puml",
+	FormatJSON:     ".json",
+}
+
+// Options of a single rendering
+type Options struct {
+	Config    map[string]interface{} // used by the package-level Render only; nil: the embedded config.json (see package defaults)
+	Includes  string                 // (semicolon separated) include directories, used by the package-level Render only
+	Selection string                 // same as '-select' command line argument
+	Depth     int                    // same as '-depth' command line argument
+	Exclude   string                 // same as '-exclude' command line argument
+	Format    string                 // "dot" (default), "mermaid", "json", a template set (e.g. "plantuml"), "svg", "png" - "png" requires 'graphviz', "svg" falls back to the native renderer. the rest are rejected (see UnsupportedFormatError)
+}
+
+// Render processes given source (a .proto blob or a file name) using a new Session
+func Render(ctx context.Context, source string, opts Options) ([]byte, error) {
+	config := opts.Config
+	if config == nil {
+		var err error
+		if config, err = defaults.Config(); err != nil {
+			return nil, err
+		}
+	}
+	s, err := NewSession(config, opts.Includes)
+	if err != nil {
+		return nil, err
+	}
+	return s.Render(ctx, source, opts)
+}
+
+// RenderableFormat tells if the given format (see Options.Format) can be produced by Render
+func (s *Session) RenderableFormat(format string) bool {
+	return len(format) == 0 || format == FormatSvg || format == FormatPng || s.SupportedFormat(format)
+}
+
+// Render processes given source (a .proto blob or a file name) without touching the 'generated' location.
+// safe to call concurrently: every call gets its own pbstate
+func (s *Session) Render(ctx context.Context, source string, opts Options) ([]byte, error) {
+	pbs, data, err := s.render(ctx, source, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Format) == 0 || s.SupportedFormat(opts.Format) {
+		return data, nil
+	}
+	return pbs.convert(ctx, data, opts.Format)
+}
+
+// RenderSvg is Render producing both the .dot and the .svg of the same diagram, processing the source once
+func (s *Session) RenderSvg(ctx context.Context, source string, opts Options) (dot []byte, svg []byte, err error) {
+	opts.Format = FormatDot
+	pbs, dot, err := s.render(ctx, source, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if svg, err = pbs.convert(ctx, dot, FormatSvg); err != nil {
+		return nil, nil, err
+	}
+	return dot, svg, nil
+}
+
+// processes the source into the format produced directly (see format2extension), the .dot one for the rest
+func (s *Session) render(ctx context.Context, source string, opts Options) (*pbstate, []byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	if !s.RenderableFormat(opts.Format) {
+		return nil, nil, &UnsupportedFormatError{Format: opts.Format}
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	pbs := NewPbs(s)
+	if err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("failed to process the source: %v", r)
+			}
+		}()
+
+		pbs.inMemory = true
+		pbs.depth = opts.Depth
+		if pbs.exclusions, err = s.exclusions(opts.Exclude); err != nil {
+			return err
+		}
+		if s.SupportedFormat(opts.Format) {
+			pbs.format = opts.Format
+		}
+		pbs.AddWriter(buffer)
+		return process(pbs, source, opts.Selection)
+	}(); err != nil {
+		return nil, nil, err
+	}
+	return pbs, buffer.Bytes(), nil
+}
+
+// converts the .dot produced by pbs into the given format ("svg" falls back to the native renderer)
+func (pbs *pbstate) convert(ctx context.Context, dot []byte, format string) ([]byte, error) {
+	if format == FormatSvg && pbs.session.native() {
+		return pbs.nativeSvg()
+	}
+	return pbs.session.Convert(ctx, dot, format)
+}
+
+// Process processes given source (a .proto blob, a file name or a glob pattern) into the 'generated' location.
+// returns the name of the produced file (see Session.Format). the .svg file is produced here too, if the native renderer is in use
+func (s *Session) Process(source, selection string) (string, error) {
+	return s.ProcessAll([]string{source}, selection)
+}
+
+// ProcessAll is Process for many sources: all of them are shown as a single diagram
+func (s *Session) ProcessAll(sources []string, selection string) (string, error) {
+	pbs := NewPbs(s)
+	pbs.format = s.Format
+	pbs.report = s.Report
+	pbs.depth = s.Depth
+	exclusions, err := s.exclusions(s.Exclude)
+	if err != nil {
+		return "", err
+	}
+	pbs.exclusions = exclusions
+	if err := processRoots(pbs, sources, selection); err != nil {
+		return "", err
+	}
+	if s.producesDot() && s.Option(generateSvg) && s.native() && len(pbs.outputFile) > 0 {
+		s.status("generating .svg file (natively)")
+		if _, err := pbs.writeNativeSvg(pbs.outputFile); err != nil {
+			s.alert("failed to generate .svg file:", err)
+			return pbs.outputFile, &ConversionError{Format: "svg", Err: err}
+		}
+	}
+	return pbs.outputFile, nil
+}
+
+// the files produced by Process are the .dot ones, i.e. can be fed to 'graphviz'
+func (s *Session) producesDot() bool {
+	return len(s.Format) == 0 || s.Format == FormatDot
+}
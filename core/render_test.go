@@ -0,0 +1,97 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"context"
+	"github.com/seamia/protodot/defaults"
+	"strings"
+	"testing"
+)
+
+const renderProto = `
+syntax = "proto3";
+package demo;
+
+message Order {
+	Item item = 1;
+}
+
+message Item {
+	string name = 1;
+}
+`
+
+func TestRenderDefaults(t *testing.T) {
+	cases := []struct {
+		format   string
+		contains string
+	}{
+		{"", "digraph"},
+		{FormatDot, "Node_Ja_"},
+		{FormatMermaid, "classDiagram"},
+		{FormatJSON, `"demo.Item"`},
+		{FormatPlantUML, "@startuml"},
+	}
+
+	for _, c := range cases {
+		data, err := Render(context.Background(), renderProto, Options{Format: c.format})
+		if err != nil {
+			t.Errorf("format [%s]: %v", c.format, err)
+			continue
+		}
+		if !strings.Contains(string(data), c.contains) || !strings.Contains(string(data), "Order") {
+			t.Errorf("format [%s]: no %q in:\n%s", c.format, c.contains, data)
+		}
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	if _, err := Render(context.Background(), renderProto, Options{Format: "bogus"}); err == nil {
+		t.Errorf("no error for the unknown format")
+	} else if _, ok := err.(*UnsupportedFormatError); !ok {
+		t.Errorf("unexpected error for the unknown format: %v", err)
+	}
+
+	if _, err := Render(context.Background(), renderProto, Options{Config: map[string]interface{}{}}); err == nil {
+		t.Errorf("no error for the configuration without templates")
+	} else if _, ok := err.(*NoTemplatesError); !ok {
+		t.Errorf("unexpected error for the configuration without templates: %v", err)
+	}
+}
+
+func TestRenderTemplateErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string // "" - removed from the configuration
+	}{
+		{"missing", ""},
+		{"broken", "{{.NoSuchField}}"},
+	}
+
+	for _, c := range cases {
+		config, err := defaults.Config()
+		if err != nil {
+			t.Fatalf("failed to load the embedded config: %v", err)
+		}
+		templates := make(map[string]interface{})
+		for name, text := range config["templates"].(map[string]interface{}) {
+			templates[name] = text
+		}
+		if len(c.template) > 0 {
+			templates["entry.simple"] = c.template
+		} else {
+			delete(templates, "entry.simple")
+		}
+		config["templates"] = templates
+
+		data, err := Render(context.Background(), renderProto, Options{Config: config})
+		if err == nil {
+			t.Errorf("%s template: no error, got:\n%s", c.name, data)
+		} else if failure, ok := err.(*RenderError); !ok || failure.Template != "entry.simple" {
+			t.Errorf("%s template: unexpected error: %v", c.name, err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"strings"
+)
+
+// type name lookup, the way protoc does it:
+//   - ".a.b.C" is fully qualified and is looked up as is;
+//   - "C" and "b.C" are looked up in the scope of the referring type first, then in the enclosing scopes, up to the root;
+//   - for "b.C" the first found "b" (a type or a package) decides: if there is no "C" in it, the lookup fails;
+//   - only the types declared in the file itself, in the files it imports and in the files those import publicly are visible.
+
+// names of the files whose declarations are visible from the given one
+func (pbs *pbstate) visibleFiles(file string) map[string]bool {
+	visible := map[string]bool{file: true}
+
+	// 'import public' makes the dependencies of the imported file visible too (transitively)
+	var addPublic func(name string)
+	addPublic = func(name string) {
+		if info, found := pbs.knownFiles[name]; found {
+			for _, one := range info.public {
+				if !visible[one] {
+					visible[one] = true
+					addPublic(one)
+				}
+			}
+		}
+	}
+
+	if info, found := pbs.knownFiles[file]; found {
+		for _, one := range info.dependencies {
+			visible[one] = true
+			addPublic(one)
+		}
+	}
+	return visible
+}
+
+// finds the type with the given (fully qualified, without the leading dot) name among the visible ones
+func (pbs *pbstate) visibleType(name string, visible map[string]bool) (FullName, bool) {
+	// the types declared without a package are kept as ".Name"
+	for _, full := range []FullName{FullName(name), FullName(separator + name)} {
+		if info, found := pbs.types237[full]; found {
+			switch info.typename {
+			case typenameMessage, typenameEnum:
+				if visible[info.filename] {
+					return full, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// is 'name' a package (or the leading part of one) declared by any of the visible files?
+func (pbs *pbstate) visiblePackage(name string, visible map[string]bool) bool {
+	for file := range visible {
+		if info, found := pbs.knownFiles[file]; found {
+			if info.packageName == name || strings.HasPrefix(info.packageName, name+separator) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// is 'name' something that can contain other declarations: a message, an enum, a service or a package?
+func (pbs *pbstate) visibleAggregate(name string, visible map[string]bool) bool {
+	if _, found := pbs.visibleType(name, visible); found {
+		return true
+	}
+	for _, full := range []FullName{FullName(name), FullName(separator + name)} {
+		if info, found := pbs.types237[full]; found && info.typename == typenameService && visible[info.filename] {
+			return true
+		}
+	}
+	return pbs.visiblePackage(name, visible)
+}
+
+func qualified(scope, name string) string {
+	if len(scope) == 0 {
+		return name
+	}
+	return scope + separator + name
+}
+
+// the scope 'scope' is declared in, "" for the root one
+func enclosingScope(scope string) string {
+	if index := strings.LastIndex(scope, separator); index >= 0 {
+		return scope[:index]
+	}
+	return ""
+}
+
+// resolves 'local' (the type as it is written) used inside of 'scope' (the full name of the referring type)
+func (pbs *pbstate) lookupType(scope FullName, local OriginalName, visible map[string]bool) (FullName, bool) {
+	name := string(local)
+	if strings.HasPrefix(name, separator) {
+		return pbs.visibleType(name[len(separator):], visible)
+	}
+
+	first := name
+	if index := strings.Index(name, separator); index >= 0 {
+		first = name[:index]
+	}
+
+	current := strings.TrimPrefix(string(scope), separator)
+	for {
+		candidate := qualified(current, first)
+		if first == name {
+			if full, found := pbs.visibleType(candidate, visible); found {
+				return full, true
+			}
+		} else if pbs.visibleAggregate(candidate, visible) {
+			// the first part of the name is found: the rest of it has to be there
+			return pbs.visibleType(qualified(current, name), visible)
+		}
+
+		if len(current) == 0 {
+			return "", false
+		}
+		current = enclosingScope(current)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"testing"
+)
+
+// the files (and the types they declare) the resolution cases below are run against:
+//
+//	main.proto     package co.a;     imports a.proto, chain.proto, nopkg.proto
+//	a.proto        package co.a
+//	chain.proto    package co.chain; imports private.proto, imports publicly pub.proto
+//	pub.proto      package co.pub;   imports publicly b.proto
+//	b.proto        package co.b
+//	private.proto  package co.priv
+//	hidden.proto   package co.hidden (imported by nobody)
+//	nopkg.proto    no package
+func resolverFixture() *pbstate {
+	pbs := NewPbs(nil)
+
+	files := []struct {
+		name, pkg    string
+		dependencies []string
+		public       []string
+		types        map[string]string
+	}{
+		{"main.proto", "co.a", []string{"a.proto", "chain.proto", "nopkg.proto"}, nil, map[string]string{
+			"co.a.Outer":       typenameMessage,
+			"co.a.Outer.Foo":   typenameMessage,
+			"co.a.Outer.Inner": typenameMessage,
+			"co.a.Other":       typenameMessage,
+			"co.a.Api":         typenameService,
+		}},
+		{"a.proto", "co.a", nil, nil, map[string]string{
+			"co.a.Foo":    typenameMessage,
+			"co.a.Status": typenameEnum,
+			"co.a.b":      typenameMessage, // shadows package co.b for "b.Foo" used inside of co.a
+		}},
+		{"chain.proto", "co.chain", []string{"private.proto", "pub.proto"}, []string{"pub.proto"}, map[string]string{
+			"co.chain.Link": typenameMessage,
+		}},
+		{"pub.proto", "co.pub", []string{"b.proto"}, []string{"b.proto"}, map[string]string{
+			"co.pub.P": typenameMessage,
+		}},
+		{"b.proto", "co.b", nil, nil, map[string]string{
+			"co.b.Foo": typenameMessage,
+			"co.b.Bar": typenameMessage,
+		}},
+		{"private.proto", "co.priv", nil, nil, map[string]string{
+			"co.priv.X": typenameMessage,
+		}},
+		{"hidden.proto", "co.hidden", nil, nil, map[string]string{
+			"co.hidden.Secret": typenameMessage,
+			"co.a.Hidden":      typenameMessage, // in the right package, but not imported
+		}},
+		{"nopkg.proto", "", nil, nil, map[string]string{
+			".Plain": typenameMessage,
+		}},
+	}
+
+	for _, file := range files {
+		pbs.knownFiles[file.name] = &pkgInfo{
+			packageName:  file.pkg,
+			fileName:     file.name,
+			dependencies: file.dependencies,
+			public:       file.public,
+		}
+		for full, typename := range file.types {
+			pbs.types237[FullName(full)] = tinfo{fullname: FullName(full), typename: typename, filename: file.name}
+		}
+	}
+	return pbs
+}
+
+func TestLookupType(t *testing.T) {
+	pbs := resolverFixture()
+	visible := pbs.visibleFiles("main.proto")
+
+	cases := []struct {
+		name  string
+		scope FullName
+		local OriginalName
+		want  FullName // "" - must not resolve
+	}{
+		// fully qualified names (leading dot) are looked up as they are, ignoring the scope
+		{"leading dot", "co.a.Outer", ".co.a.Foo", "co.a.Foo"},
+		{"leading dot nested", "co.a.Other", ".co.a.Outer.Foo", "co.a.Outer.Foo"},
+		{"leading dot imported", "co.a.Outer", ".co.b.Bar", "co.b.Bar"},
+		{"leading dot no package", "co.a.Outer", ".Plain", ".Plain"},
+		{"leading dot partial", "co.a.Outer", ".a.Foo", ""},
+		{"leading dot not imported", "co.a.Outer", ".co.hidden.Secret", ""},
+
+		// the innermost scope wins
+		{"inner shadows outer", "co.a.Outer", "Foo", "co.a.Outer.Foo"},
+		{"inner shadows outer from nested", "co.a.Outer.Inner", "Foo", "co.a.Outer.Foo"},
+		{"outer when not shadowed", "co.a.Other", "Foo", "co.a.Foo"},
+		{"sibling", "co.a.Outer.Inner", "Other", "co.a.Other"},
+		{"enum", "co.a.Outer", "Status", "co.a.Status"},
+		{"no package", "co.a.Outer", "Plain", ".Plain"},
+
+		// the partially qualified names: the first part found decides
+		{"partial package prefix", "co.a.Outer", "a.Foo", "co.a.Foo"},
+		{"partial package prefix from root", "co.a.Outer", "co.b.Foo", "co.b.Foo"},
+		{"partial prefix through a message", "co.a.Other", "Outer.Inner", "co.a.Outer.Inner"},
+		{"partial prefix shadowed by a message", "co.a.Outer", "b.Foo", ""},
+		{"partial prefix shadowed by a service", "co.a.Outer", "Api.Foo", ""},
+		{"partial prefix missing tail", "co.a.Outer", "a.Nothing", ""},
+
+		// 'import public' re-exports
+		{"import public", "co.a.Outer", "co.pub.P", "co.pub.P"},
+		{"import public chain", "co.a.Outer", "co.b.Bar", "co.b.Bar"},
+		{"direct import", "co.a.Outer", "co.chain.Link", "co.chain.Link"},
+		{"import of an import", "co.a.Outer", "co.priv.X", ""},
+
+		// declared, but in a file that is not imported
+		{"not imported", "co.a.Outer", "co.hidden.Secret", ""},
+		{"not imported same package", "co.a.Outer", "Hidden", ""},
+		{"unknown", "co.a.Outer", "Nothing", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, found := pbs.lookupType(c.scope, c.local, visible)
+			if found != (len(c.want) > 0) || got != c.want {
+				t.Errorf("lookupType(%q, %q) = %q, %v; want %q", c.scope, c.local, got, found, c.want)
+			}
+		})
+	}
+}
+
+func TestVisibleFiles(t *testing.T) {
+	pbs := resolverFixture()
+
+	cases := []struct {
+		file string
+		want []string
+	}{
+		{"main.proto", []string{"main.proto", "a.proto", "chain.proto", "nopkg.proto", "pub.proto", "b.proto"}},
+		{"chain.proto", []string{"chain.proto", "private.proto", "pub.proto", "b.proto"}},
+		{"pub.proto", []string{"pub.proto", "b.proto"}},
+		{"hidden.proto", []string{"hidden.proto"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.file, func(t *testing.T) {
+			visible := pbs.visibleFiles(c.file)
+			if len(visible) != len(c.want) {
+				t.Errorf("visibleFiles(%q) = %v; want %v", c.file, visible, c.want)
+			}
+			for _, one := range c.want {
+				if !visible[one] {
+					t.Errorf("visibleFiles(%q): %q is not visible", c.file, one)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"errors"
+	"github.com/seamia/tools/support"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// the root files: the ones given by the caller (as opposed to the imported ones)
+func (pbs *pbstate) isRoot(file string) bool {
+	for _, one := range pbs.roots {
+		if one == file {
+			return true
+		}
+	}
+	return false
+}
+
+// the same file, named by its path (as a root) and by an import, e.g. "api/v1/common.proto" and "v1/common.proto"
+func sameFile(path, imported string) bool {
+	path, imported = filepath.ToSlash(path), filepath.ToSlash(imported)
+	return path == imported || strings.HasSuffix(path, "/"+imported)
+}
+
+// the root the import refers to, if any: a file given as a root and imported by another one is loaded once
+func (pbs *pbstate) importedRoot(imported string) (string, bool) {
+	for _, one := range pbs.roots {
+		if sameFile(one, imported) {
+			return one, true
+		}
+	}
+	return "", false
+}
+
+// expands the glob patterns among the given sources, e.g. "api/*.proto"; the blobs are left as they are
+func rootFiles(sources []string) ([]string, error) {
+	var result []string
+	for _, source := range sources {
+		if strings.Count(source, "\n") > 1 {
+			result = append(result, source)
+			continue
+		}
+		matches, err := filepath.Glob(source)
+		if err != nil {
+			return nil, &MissingFileError{Name: source, Err: err}
+		}
+		if len(matches) == 0 {
+			// not a pattern (or nothing matches it): let 'load' report it
+			matches = []string{source}
+		}
+		result = append(result, matches...)
+	}
+	return result, nil
+}
+
+// loads all the given sources into pbs and shows them as a single diagram.
+// returns the first error encountered while processing any of the files
+func processRoots(pbs *pbstate, sources []string, selection string) error {
+	roots, err := rootFiles(sources)
+	if err != nil {
+		return err
+	}
+	if len(roots) == 0 {
+		return errors.New("no source file specified")
+	}
+
+	pbs.selection = selection
+	pbs.roots = roots // the blobs get their names once loaded
+	for index, name := range roots {
+		if strings.Count(name, "\n") > 1 {
+			pbs.rootDir = "~fake~"
+		} else {
+			pbs.rootDir, _ = pathSplit(name)
+		}
+		original, err := pbs.load(name)
+		if err != nil {
+			return err
+		}
+		pbs.roots[index] = original
+	}
+	if len(pbs.roots) > 1 {
+		pbs.trace("showing", len(pbs.roots), "root files as one diagram:", pbs.roots)
+		pbs.proto = strings.Join(pbs.roots, ";")
+	}
+
+	if pbs.err != nil {
+		// do not produce (a misleading) partial output
+		return pbs.err
+	}
+	if !pbs.inMemory {
+		pbs.openOutput()
+	}
+	pbs.present(selection)
+	return pbs.err
+}
+
+// the file the output goes to, in the 'generated' location
+func (pbs *pbstate) openOutput() {
+	genDir, err := support.GetLocation(pbs.session.config, EntryGenerated)
+	if err != nil {
+		pbs.trace("missing 'generated' location in the provided config")
+		genDir = ""
+	}
+
+	outputFileName := getProtoName(pbs.proto, pbs.selection)
+	if len(pbs.session.Output) > 0 {
+		outputFileName = pbs.session.Output
+	}
+
+	extension, found := format2extension[pbs.format]
+	if !found {
+		extension = "." + pbs.format
+	}
+	if len(pbs.format) == 0 {
+		extension = format2extension[FormatDot]
+	}
+	target := path.Join(genDir, outputFileName+extension)
+	pbs.outputFile = target
+	output := NewCreateOnWrite(target)
+	output.log = pbs.session.logger()
+	pbs.AddWriter(output)
+}
+
+// writes whatever the selection asks for
+func (pbs *pbstate) present(selection string) {
+	pbs.cycles = pbs.importCycles()
+	pbs.showCycles(pbs.cycles)
+
+	if pbs.report == reportUnused {
+		pbs.unused = pbs.findUnused()
+		pbs.showUnused()
+	}
+
+	if len(selection) > 0 {
+		if selection == selectImports {
+			pbs.showDependencyTree()
+		} else if selection == selectPackages {
+			pbs.showPackageTree()
+		} else if strings.HasPrefix(selection, selectUsers) {
+			pbs.showSelectedUsers(selection[len(selectUsers):])
+		} else {
+			pbs.showSelectedInclusion(selection)
+		}
+	} else {
+		pbs.showInclusion(true, true)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	selectAll   = "*"   // the types declared in the root file(s)
+	selectExact = "="   // e.g. "=.pkg.Foo": that very type, nothing else
+	selectRegex = "re:" // e.g. "re:/.*Request$/": every type whose full name matches
+)
+
+// the kinds of the types a pattern can pick: the rest (extends, missing types) come along as the dependencies
+var selectable = map[string]bool{
+	typenameMessage: true,
+	typenameEnum:    true,
+	typenameService: true,
+	typenameRPC:     true,
+}
+
+// the full names have no leading '.' (unless declared without a package), the patterns may have one either way
+func withoutDot(name string) string {
+	return strings.TrimPrefix(name, separator)
+}
+
+func isGlob(term string) bool {
+	return strings.ContainsAny(term, "*?[")
+}
+
+// returns the matcher for the pattern term ('re:', glob), or nil if the term is not a pattern
+func selectionPattern(term string) (func(FullName) bool, error) {
+	switch {
+	case strings.HasPrefix(term, selectRegex):
+		expr := term[len(selectRegex):]
+		if len(expr) > 1 && strings.HasPrefix(expr, "/") && strings.HasSuffix(expr, "/") {
+			expr = expr[1 : len(expr)-1]
+		}
+		re, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return nil, &InvalidPatternError{Pattern: term, Err: err}
+		}
+		return func(full FullName) bool {
+			return re.MatchString(withoutDot(string(full))) || re.MatchString(separator+withoutDot(string(full)))
+		}, nil
+
+	case isGlob(term):
+		re, err := globRegexp(withoutDot(term))
+		if err != nil {
+			return nil, &InvalidPatternError{Pattern: term, Err: err}
+		}
+		return func(full FullName) bool {
+			return re.MatchString(withoutDot(string(full)))
+		}, nil
+	}
+	return nil, nil
+}
+
+// the glob pattern (e.g. "pkg.*.Get?") as a regular expression: "*" and "?" stay within a name segment, "**" matches across them (e.g. "pkg.**")
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	expr := bytes.NewBufferString("^")
+	for index := 0; index < len(pattern); index++ {
+		switch one := pattern[index]; one {
+		case '*':
+			if index+1 < len(pattern) && pattern[index+1] == '*' {
+				expr.WriteString(".*")
+				index++
+			} else {
+				expr.WriteString("[^.]*")
+			}
+		case '?':
+			expr.WriteString("[^.]")
+		case '[':
+			end := strings.IndexByte(pattern[index+1:], ']')
+			if end < 0 {
+				return nil, errors.New("missing ']' in [" + pattern + "]")
+			}
+			class := pattern[index+1 : index+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			index += end + 1
+		case '\\':
+			if index+1 < len(pattern) {
+				index++
+			}
+			expr.WriteString(regexp.QuoteMeta(pattern[index : index+1]))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(one)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// a plain term: the types whose full name ends with it, preferring the ones where it starts at a name boundary
+func (pbs *pbstate) matchName(term string) []FullName {
+	var locals, whole []FullName
+	for fulltype := range pbs.types237 {
+		if strings.HasSuffix(string(fulltype), term) {
+			locals = append(locals, fulltype)
+			if strings.HasPrefix(term, ".") || strings.HasSuffix(string(fulltype), "."+term) {
+				whole = append(whole, fulltype)
+			}
+		}
+	}
+	if len(whole) > 0 {
+		return whole
+	}
+
+	if len(locals) == 0 {
+		// let's do a more relaxed search
+		for fulltype := range pbs.types237 {
+			if strings.Index(string(fulltype), term) >= 0 {
+				locals = append(locals, fulltype)
+			}
+		}
+	}
+	return locals
+}
+
+// turns the ';' separated selection into the full names of the selected types
+func (pbs *pbstate) expandSelection(selection string) ([]FullName, error) {
+	matches := make([]FullName, 0)
+
+	// deal with the special case(s) first
+	if selection == selectAll {
+		// include only entities defined in the root file(s) (and their dependencies)
+		for fulltype, info := range pbs.types237 {
+			if pbs.isRoot(info.filename) {
+				matches = append(matches, fulltype)
+			} else {
+				pbs.debug("            excluding:", fulltype)
+			}
+		}
+		return matches, nil
+	}
+	for _, root := range strings.Split(selection, ";") {
+		root = strings.TrimSpace(root)
+		if len(root) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(root, selectExact) {
+			name := withoutDot(root[len(selectExact):])
+			full, found := FullName(name), false
+			for _, one := range []FullName{FullName(name), FullName(separator + name)} {
+				if _, found = pbs.types237[one]; found {
+					full = one
+					break
+				}
+			}
+			if !found {
+				return nil, &SelectionError{Selection: root}
+			}
+			matches = append(matches, full)
+			continue
+		}
+
+		match, err := selectionPattern(root)
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			found := 0
+			for fulltype, info := range pbs.types237 {
+				if selectable[info.typename] && match(fulltype) {
+					matches = append(matches, fulltype)
+					found++
+				}
+			}
+			if found == 0 {
+				return nil, &SelectionError{Selection: root}
+			}
+			pbs.trace("pattern [", root, "] matches", found, "type(s)")
+			continue
+		}
+
+		locals := pbs.matchName(root)
+		if len(locals) == 0 {
+			return nil, &SelectionError{Selection: root}
+		}
+		if len(locals) > 1 {
+			sort.Slice(locals, func(i, j int) bool { return locals[i] < locals[j] })
+			return nil, &AmbiguousSelectionError{Selection: root, Matches: locals}
+		}
+		matches = append(matches, locals[0])
+	}
+
+	// the map above has no order: keep the output stable
+	sort.Slice(matches, func(i, j int) bool { return matches[i] < matches[j] })
+	return matches, nil
+}
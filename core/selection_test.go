@@ -0,0 +1,58 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"testing"
+)
+
+func TestSelectionPattern(t *testing.T) {
+	cases := []struct {
+		pattern string
+		full    FullName
+		want    bool
+	}{
+		{"co.*.Foo", "co.a.Foo", true},
+		{"co.*.Foo", "co.a.b.Foo", false},
+		{"co.**.Foo", "co.a.b.Foo", true},
+		{"co.**.Foo", "co.a.Foo", true},
+		{"pkg.*", "pkg.Foo", true},
+		{"pkg.*", "pkg.sub.Foo", false},
+		{"pkg.**", "pkg.sub.Foo", true},
+		{".pkg.*", "pkg.Foo", true},
+		{"*.Get?", "api.GetA", true},
+		{"*.Get?", "api.Get.", false},
+		{"*.Get?", "api.v1.GetA", false},
+		{"*Request", ".CreateRequest", true},
+		{"pkg.[AB]ar", "pkg.Bar", true},
+		{"pkg.[!AB]ar", "pkg.Bar", false},
+		{"pkg.[!AB]ar", "pkg.Car", true},
+		{"pkg.Foo+*", "pkg.Foo+Bar", true},
+		{"re:/.*Request$/", "api.v1.CreateRequest", true},
+		{"re:api\\.[^.]*", "api.v1.Foo", false},
+	}
+
+	for _, c := range cases {
+		match, err := selectionPattern(c.pattern)
+		if err != nil || match == nil {
+			t.Errorf("selectionPattern(%q): %v", c.pattern, err)
+			continue
+		}
+		if got := match(c.full); got != c.want {
+			t.Errorf("selectionPattern(%q) on %q = %v; want %v", c.pattern, c.full, got, c.want)
+		}
+	}
+}
+
+func TestSelectionPatternInvalid(t *testing.T) {
+	for _, pattern := range []string{"pkg.[Foo", "re:(Foo"} {
+		if _, err := selectionPattern(pattern); err == nil {
+			t.Errorf("selectionPattern(%q): no error", pattern)
+		}
+	}
+	if match, err := selectionPattern("pkg.Foo"); match != nil || err != nil {
+		t.Errorf("selectionPattern(%q): not a pattern", "pkg.Foo")
+	}
+}
@@ -2,11 +2,10 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package core
 
 import (
 	"errors"
-	"flag"
 	"github.com/seamia/tools/support"
 	"io"
 	"os"
@@ -68,11 +67,6 @@ func Exists(name string) bool {
 }
 
 //----------------------------------------------------------------------------------------------------------------------
-var (
-	g_includes []string = nil
-	g_incs              = flag.String("inc", "", "Include directories (semicolon separated)")
-)
-
 func openLocalFile(file string) (io.Reader, error) {
 	return os.Open(file)
 }
@@ -90,63 +84,48 @@ func locate(name, rootDir string) string {
 	return ""
 }
 
-func Find(name, rootDir string) (io.Reader, error) {
-
-	if g_includes == nil {
-		// if it is the first time we're called: init the 'inclides' list
-		g_includes = make([]string, 0)
-
-		// 1. get the includes from the config file
-		if includes, found := g_config["includes"].([]interface{}); found {
-			for _, include := range includes {
-				candidate := os.ExpandEnv(include.(string))
-				if len(candidate) > 0 {
-					g_includes = append(g_includes, candidate)
-				}
-			}
-		}
-
-		// 2. get the includes from the command line
-		parts := strings.Split(*g_incs, ";")
-		for _, part := range parts {
-			candidate := os.ExpandEnv(part)
-			if len(candidate) > 0 {
-				g_includes = append(g_includes, candidate)
-			}
-		}
+func (s *Session) Find(name, rootDir string) (io.Reader, error) {
+	found, err := s.resolve(name, rootDir)
+	if err != nil {
+		return nil, err
 	}
+	return openLocalFile(found)
+}
+
+// the location of the given file: as is, in one of the include folders or somewhere in the (partial) root directory
+func (s *Session) resolve(name, rootDir string) (string, error) {
 
 	if Exists(name) {
-		return openLocalFile(name)
+		return name, nil
 	}
 
-	includes := make([]string, len(g_includes), len(g_includes)+1)
-	copy(includes, g_includes)
+	includes := make([]string, len(s.includes), len(s.includes)+1)
+	copy(includes, s.includes)
 	includes = append(includes, rootDir)
 
 	for _, include := range includes {
 		candidate := path.Join(include, name)
 		if Exists(candidate) {
-			debug("-- found file", name, "in one of the include folders:", include)
-			return openLocalFile(candidate)
+			s.debug("-- found file", name, "in one of the include folders:", include)
+			return candidate, nil
 		}
 	}
 
-	trace("!! file", name, "was not found in any of the include folders:", g_includes)
+	s.trace("!! file", name, "was not found in any of the include folders:", s.includes)
 
 	{
 		// let's try to find the required file somewhere in the (partial) root directory
 		found := locate(name, rootDir)
 		if len(found) > 0 {
-			return openLocalFile(found)
+			return found, nil
 		}
-		status("*** failed to find file [", name, "] with root [", rootDir, "]")
+		s.status("*** failed to find file [", name, "] with root [", rootDir, "]")
 	}
 
 	// todo: enable downloads later?
-	// return downloadFile(name)
+	// return s.downloadFile(name)
 
-	return nil, errors.New("Failed to find file [" + name + "].")
+	return "", errors.New("Failed to find file [" + name + "].")
 }
 
 func getProtoName(raw, suffix string) string {
@@ -159,10 +138,7 @@ func getProtoName(raw, suffix string) string {
 
 func pathSplit(path string) (dir, file string) {
 	if abs, err := filepath.Abs(path); err == nil {
-		if abs != path {
-			debug("*** replacing [", path, "] with [", abs, "]")
-			path = abs
-		}
+		path = abs
 	}
 	i := strings.LastIndex(path, string(os.PathSeparator))
 	return path[:i+1], path[i+1:]
@@ -0,0 +1,237 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"fmt"
+	"github.com/emicklei/proto"
+	"github.com/seamia/protodot/plus"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+//----------------------------------------------------------------------------------------------------------------------
+// presentation
+type table struct {
+	*plus.Table
+	name     string
+	rows     []row    // the same content, for the renderers not using templates
+	reserved []string // shown at the bottom of the table
+	err      error    // the first failure to render the table
+}
+
+// a line of the table: 'color' is the name of the color (see 'colors' section of the config)
+type row struct {
+	cells      []string
+	port       string
+	color      string
+	span       bool
+	title      string // tooltip
+	deprecated bool
+}
+
+var kind2color = map[Kind]string{
+	Simple:  "type.simple",
+	Message: "type.message",
+	Enum:    "type.enum",
+	Missing: "type.missing",
+}
+
+// prefix: name of the template to start the table with, e.g. "message.prefix"
+func newTable(templates *plus.Templates, prefix, name string, full FullName, unique UniqueName, comment string) *table {
+	t := table{
+		Table: templates.NewTable(),
+		name:  name,
+	}
+
+	entry := OneOfEntry{
+		Name:    name,
+		Unique:  unique,
+		Type:    string(full),
+		Comment: comment,
+	}
+	t.apply(prefix, entry)
+
+	return &t
+}
+
+// renders the template 'name' at the end of the table
+func (t *table) apply(name string, payload interface{}) {
+	if err := t.Apply(name, payload); err != nil {
+		t.fail(&RenderError{Template: name, Err: err})
+	}
+}
+
+func (t *table) fail(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+var kind2entry = map[Kind]string{
+	Simple:  "entry.simple",
+	Message: "entry.message",
+	Enum:    "entry.enum",
+	Missing: "entry.missing",
+}
+
+func (t *table) addRow(repeated, typ, name, ordinal, comment string, options []*proto.Option, kind Kind) {
+	tmplName := kind2entry[kind]
+	if len(tmplName) > 0 {
+		entry := OneOfEntry{
+			Name:    name,
+			Type:    typ,
+			Ordinal: ordinal,
+			Prefix:  repeated,
+			Comment: comment,
+		}
+		entry.Options, entry.Deprecated = fieldOptions(options)
+		t.apply(tmplName, entry)
+		t.rows = append(t.rows, row{cells: []string{repeated, ordinal, name, typ}, port: name, color: kind2color[kind], title: comment, deprecated: entry.Deprecated})
+	} else {
+		t.fail(fmt.Errorf("unhandled kind [%d] of field [%s]", kind, name))
+	}
+}
+
+var kind2map = map[Kind]string{
+	Simple:  "map.simple",
+	Message: "map.message",
+	Enum:    "map.enum",
+	Missing: "map.missing",
+}
+
+func (t *table) addMapRow(name, keyType, typ, ordinal, comment string, options []*proto.Option, kind Kind) {
+
+	tmplName := kind2map[kind]
+	if len(tmplName) > 0 {
+		entry := OneOfEntry{
+			Name:    name,
+			Type:    typ,
+			Ordinal: ordinal,
+			Prefix:  "",
+			KeyType: keyType,
+			Comment: comment,
+		}
+		entry.Options, entry.Deprecated = fieldOptions(options)
+		t.apply(tmplName, entry)
+		t.rows = append(t.rows, row{cells: []string{"", ordinal, name, "map<" + keyType + ", " + typ + ">"}, port: name, color: kind2color[kind], title: comment, deprecated: entry.Deprecated})
+	} else {
+		t.fail(fmt.Errorf("unhandled kind [%d] of field [%s]", kind, name))
+	}
+}
+
+type OneOfEntry struct {
+	Unique     UniqueName
+	Name       string
+	KeyType    string
+	Type       string
+	Ordinal    string
+	Prefix     string
+	Comment    string // leading and inline comments
+	Options    []FieldOption
+	Deprecated bool // [deprecated = true]
+}
+
+var kind2template = map[Kind]string{
+	Simple:  "oneof.entry.simple",
+	Message: "oneof.entry.message",
+	Enum:    "oneof.entry.enum",
+	Missing: "oneof.entry.missing",
+}
+
+func (t *table) addOneof(fullname FullName, what *proto.Oneof, pbs *pbstate) {
+
+	entry := OneOfEntry{
+		Name:    what.Name,
+		Comment: commentText(what.Comment),
+	}
+	t.apply("oneof.entry.prefix", entry)
+	t.rows = append(t.rows, row{cells: []string{what.Name}, color: "oneof.background", span: true, title: entry.Comment})
+
+	for _, element := range what.Elements {
+		switch actual := element.(type) {
+		case *proto.OneOfField:
+			if pbs.droppedField(fullname, actual.Type) {
+				continue
+			}
+			kind := pbs.getKind(fullname, OriginalName(actual.Type))
+			if tmplName := kind2template[kind]; len(tmplName) > 0 {
+				payload := OneOfEntry{
+					Name:    actual.Name,
+					Type:    actual.Type,
+					Ordinal: strconv.Itoa(actual.Sequence),
+					Comment: commentText(actual.Comment, actual.InlineComment),
+				}
+				payload.Options, payload.Deprecated = fieldOptions(actual.Options)
+				t.apply(tmplName, payload)
+				t.rows = append(t.rows, row{cells: []string{"", payload.Ordinal, actual.Name, actual.Type}, port: actual.Name, color: kind2color[kind], title: payload.Comment, deprecated: payload.Deprecated})
+			} else {
+				t.fail(fmt.Errorf("unhandled kind [%d] of field [%s]", kind, actual.Name))
+			}
+
+		case *proto.Option:
+			ignoring("ignoring options for now")
+
+		case *proto.Comment:
+			// the comments attached to the fields are taken care of above
+
+		case *proto.Group:
+			if pbs.droppedField(fullname, actual.Name) {
+				continue
+			}
+			kind := pbs.getKind(fullname, OriginalName(actual.Name))
+			if tmplName := kind2template[kind]; len(tmplName) > 0 {
+				payload := OneOfEntry{
+					Name:    groupField(actual),
+					Type:    actual.Name,
+					Ordinal: strconv.Itoa(actual.Sequence),
+					Comment: commentText(actual.Comment),
+				}
+				t.apply(tmplName, payload)
+				t.rows = append(t.rows, row{cells: []string{"", payload.Ordinal, payload.Name, actual.Name}, port: payload.Name, color: kind2color[kind], title: payload.Comment})
+			}
+
+		default:
+			rname := reflect.TypeOf(actual).Elem().Name()
+			unhandled("\t", "UNKNOWN1", actual, "", rname)
+		}
+	}
+
+	t.apply("oneof.entry.suffix", entry)
+}
+
+// (proto2) 'extensions 100 to 199;'
+func (t *table) addExtensions(ranges, comment string) {
+	entry := OneOfEntry{
+		Name:    "extensions",
+		Type:    ranges,
+		Comment: comment,
+	}
+	t.apply("message.extensions", entry)
+	t.rows = append(t.rows, row{cells: []string{"extensions " + ranges}, color: "extensions.background", span: true, title: comment})
+}
+
+// 'reserved 2, 15, 9 to 11;' and 'reserved "foo", "bar";' are collected into a single footer row
+func (t *table) addReserved(reserved *proto.Reserved) {
+	t.reserved = append(t.reserved, reservedText(reserved))
+}
+
+func (t *table) generate() string {
+
+	if len(t.reserved) > 0 {
+		entry := OneOfEntry{
+			Name: "reserved",
+			Type: strings.Join(t.reserved, "; "),
+		}
+		t.apply("message.reserved", entry)
+		t.rows = append(t.rows, row{cells: []string{"reserved " + entry.Type}, color: "reserved.background", span: true})
+	}
+
+	entry := OneOfEntry{Name: t.name}
+	t.apply("message.suffix", entry)
+
+	return t.String()
+}
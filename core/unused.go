@@ -0,0 +1,295 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"github.com/emicklei/proto"
+	"sort"
+	"strings"
+)
+
+const (
+	reportUnused    = "unused"
+	highlightUnused = "highlight unused"
+)
+
+// SupportedReport tells if the given report (see Session.Report) can be produced
+func SupportedReport(report string) bool {
+	return len(report) == 0 || report == reportUnused
+}
+
+type unusedImport struct {
+	File   string `json:"file"`
+	Import string `json:"import"`
+}
+
+// '-report unused': the imports none of the types of which are used and the types of the root file no rpc depends on
+type unusedReport struct {
+	Imports []unusedImport `json:"imports"`
+	Types   []FullName     `json:"types"`
+}
+
+// the file itself and everything it re-exports with 'import public'
+func (pbs *pbstate) exportedFiles(file string) map[string]bool {
+	files := map[string]bool{file: true}
+	pending := []string{file}
+	for len(pending) > 0 {
+		info, found := pbs.knownFiles[pending[0]]
+		pending = pending[1:]
+		if !found {
+			continue
+		}
+		for _, one := range info.public {
+			if !files[one] {
+				files[one] = true
+				pending = append(pending, one)
+			}
+		}
+	}
+	return files
+}
+
+// the dependency (or one of the files it re-exports) is not loaded
+func (pbs *pbstate) failedImport(dependency string) bool {
+	for one := range pbs.exportedFiles(dependency) {
+		if info, found := pbs.knownFiles[one]; found && info.missing {
+			return true
+		}
+	}
+	return false
+}
+
+// from -> to, for every recorded type reference (fields, rpcs, extendees)
+func (pbs *pbstate) references() map[FullName][]FullName {
+	result := make(map[FullName][]FullName)
+	for from, tos := range pbs.inclusions {
+		source := pbs.knownNames[UniqueName(strings.Split(string(from), ":")[0])]
+		for to := range tos {
+			result[source] = append(result[source], pbs.knownNames[to])
+		}
+	}
+	return result
+}
+
+// a custom option set by a file, e.g. "(co.opts.rule)" of a field, resolved from 'scope' (see extensionFile)
+type optionUse struct {
+	scope FullName
+	name  string
+}
+
+// the fields of 'extend' are the extensions the other files may use as the custom options
+func (pbs *pbstate) recordExtensions(msg *proto.Message) {
+	scope := getParent(msg.Parent)
+	for _, element := range msg.Elements {
+		switch actual := element.(type) {
+		case *proto.NormalField:
+			pbs.extensions[FullName(qualified(scope, actual.Name))] = msg.Position.Filename
+		case *proto.Group:
+			pbs.extensions[FullName(qualified(scope, groupField(actual)))] = msg.Position.Filename
+		}
+	}
+}
+
+// records the custom options of the file, of its messages, fields, enums, enum values, services and rpcs
+func (pbs *pbstate) handleOptionUses(element proto.Visitee) {
+	var scope FullName
+	var options []*proto.Option
+	switch actual := element.(type) {
+	case *proto.Option:
+		// the options of the file, messages, oneofs, enums, services and rpcs are elements of their own
+		scope, options = optionScope(actual.Parent), []*proto.Option{actual}
+	case *proto.NormalField:
+		scope, options = optionScope(actual.Parent), actual.Options
+	case *proto.MapField:
+		scope, options = optionScope(actual.Parent), actual.Options
+	case *proto.OneOfField:
+		scope, options = optionScope(actual.Parent), actual.Options
+	case *proto.EnumField:
+		scope = optionScope(actual.Parent)
+		for _, one := range actual.Elements {
+			if option, ok := one.(*proto.Option); ok {
+				options = append(options, option)
+			}
+		}
+	}
+
+	for _, option := range options {
+		// e.g. "(google.api.http)" or "(co.opts.rule).min"; the built-in options (e.g. "deprecated") have no parentheses
+		if !strings.HasPrefix(option.Name, "(") {
+			continue
+		}
+		name := option.Name[1:]
+		if end := strings.Index(name, ")"); end >= 0 {
+			name = name[:end]
+		}
+		pbs.optionUses[pbs.proto] = append(pbs.optionUses[pbs.proto], optionUse{scope: scope, name: name})
+	}
+}
+
+// the scope the names of the options set inside of 'parent' are resolved from
+func optionScope(parent proto.Visitee) FullName {
+	switch actual := parent.(type) {
+	case *proto.Message:
+		if actual.IsExtend {
+			return FullName(getParent(actual.Parent))
+		}
+	case *proto.Enum:
+		return FullName(getParent(actual.Parent))
+	case *proto.EnumField:
+		return optionScope(actual.Parent)
+	case *proto.Service:
+		return FullName(getParent(actual.Parent))
+	case *proto.RPC:
+		return optionScope(actual.Parent)
+	case nil:
+		return ""
+	}
+	return FullName(getParent(parent))
+}
+
+// the (visible) file declaring the extension the option refers to, the innermost scope wins
+func (pbs *pbstate) extensionFile(use optionUse, visible map[string]bool) (string, bool) {
+	if strings.HasPrefix(use.name, separator) {
+		file, found := pbs.extensions[FullName(use.name[len(separator):])]
+		return file, found && visible[file]
+	}
+	current := strings.TrimPrefix(string(use.scope), separator)
+	for {
+		if file, found := pbs.extensions[FullName(qualified(current, use.name))]; found && visible[file] {
+			return file, true
+		}
+		if len(current) == 0 {
+			return "", false
+		}
+		current = enclosingScope(current)
+	}
+}
+
+func (pbs *pbstate) findUnused() *unusedReport {
+	report := unusedReport{
+		Imports: []unusedImport{},
+		Types:   []FullName{},
+	}
+	references := pbs.references()
+
+	// the files the types of every file refer to
+	usedFiles := make(map[string]map[string]bool)
+	for from, tos := range references {
+		file := pbs.types237[from].filename
+		if _, found := usedFiles[file]; !found {
+			usedFiles[file] = make(map[string]bool)
+		}
+		for _, to := range tos {
+			usedFiles[file][pbs.types237[to].filename] = true
+		}
+	}
+
+	// ... and the files declaring the custom options they set
+	for file, uses := range pbs.optionUses {
+		visible := pbs.visibleFiles(file)
+		for _, use := range uses {
+			declared, found := pbs.extensionFile(use, visible)
+			if !found {
+				// may well be declared by the import that failed to load
+				pbs.unresolved[file] = true
+				continue
+			}
+			if _, found := usedFiles[file]; !found {
+				usedFiles[file] = make(map[string]bool)
+			}
+			usedFiles[file][declared] = true
+		}
+	}
+
+	for name, info := range pbs.knownFiles {
+		for _, dependency := range info.dependencies {
+			if info.importKind(dependency) == importPublic {
+				// re-exported for the sake of the importers
+				continue
+			}
+			// the types the file could not find may well come from the import that failed to load
+			used := pbs.unresolved[name] && pbs.failedImport(dependency)
+			for one := range pbs.exportedFiles(dependency) {
+				if usedFiles[name][one] {
+					used = true
+					break
+				}
+			}
+			if !used {
+				report.Imports = append(report.Imports, unusedImport{File: name, Import: dependency})
+			}
+		}
+	}
+	sort.Slice(report.Imports, func(i, j int) bool {
+		a, b := report.Imports[i], report.Imports[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Import < b.Import
+	})
+
+	// everything reachable from the services
+	reachable := make(map[FullName]bool)
+	var pending []FullName
+	for full, info := range pbs.types237 {
+		if info.typename == typenameService {
+			pending = append(pending, full)
+		}
+	}
+	for len(pending) > 0 {
+		next := pending[0]
+		pending = pending[1:]
+		if reachable[next] {
+			continue
+		}
+		reachable[next] = true
+		pending = append(pending, references[next]...)
+	}
+
+	for full, info := range pbs.types237 {
+		if !pbs.isRoot(info.filename) || reachable[full] {
+			continue
+		}
+		if info.typename == typenameMessage || info.typename == typenameEnum {
+			report.Types = append(report.Types, full)
+		}
+	}
+	sort.Slice(report.Types, func(i, j int) bool { return report.Types[i] < report.Types[j] })
+
+	return &report
+}
+
+func (pbs *pbstate) isUnused(full FullName) bool {
+	if pbs.unused == nil || !pbs.session.Option(highlightUnused) {
+		return false
+	}
+	for _, one := range pbs.unused.Types {
+		if one == full {
+			return true
+		}
+	}
+	return false
+}
+
+func (pbs *pbstate) showUnused() {
+	report := pbs.unused
+	if len(report.Imports) == 0 {
+		pbs.status("no unused imports")
+	} else {
+		pbs.status("unused imports:")
+		for _, one := range report.Imports {
+			pbs.status("\t", one.File, "imports", one.Import)
+		}
+	}
+
+	if len(report.Types) == 0 {
+		pbs.status("every type of", pbs.proto, "is used by an rpc")
+	} else {
+		pbs.status("types of", pbs.proto, "not used by any rpc:")
+		for _, one := range report.Types {
+			pbs.status("\t", one)
+		}
+	}
+}
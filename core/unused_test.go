@@ -0,0 +1,122 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"github.com/seamia/protodot/defaults"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// the files every case below imports: the custom options and a few plain types
+var unusedFixture = map[string]string{
+	"google/protobuf/descriptor.proto": `
+syntax = "proto2";
+package google.protobuf;
+message FileOptions {}
+message MessageOptions {}
+message FieldOptions {}
+message EnumValueOptions {}
+message ServiceOptions {}
+message MethodOptions {}
+`,
+	"opts.proto": `
+syntax = "proto3";
+package co.opts;
+import "google/protobuf/descriptor.proto";
+extend google.protobuf.FileOptions { string file = 50000; }
+extend google.protobuf.MessageOptions { bool msg = 50000; }
+extend google.protobuf.FieldOptions { string rule = 50000; }
+extend google.protobuf.EnumValueOptions { int32 value = 50000; }
+extend google.protobuf.ServiceOptions { string api = 50000; }
+extend google.protobuf.MethodOptions { Http http = 50000; }
+message Http { string get = 1; }
+`,
+	"types.proto": `
+syntax = "proto3";
+package co.types;
+message T {}
+`,
+}
+
+func TestFindUnusedOptions(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		unused bool // is "opts.proto" reported
+	}{
+		{"field option", `message Req { string name = 1 [(co.opts.rule) = "x"]; }`, false},
+		{"map field option", `message Req { map<string, string> names = 1 [(co.opts.rule) = "x"]; }`, false},
+		{"oneof field option", `message Req { oneof one { string name = 1 [(co.opts.rule) = "x"]; } }`, false},
+		{"enum value option", `enum E { ZERO = 0 [(co.opts.value) = 1]; } message Req { E e = 1; }`, false},
+		{"message option", `message Req { option (co.opts.msg) = true; }`, false},
+		{"service option", `message Req {} service Other { option (co.opts.api) = "x"; }`, false},
+		{"rpc option", `message Req {} service Other { rpc Get(Req) returns (Req) { option (co.opts.http) = { get: "/" }; } }`, false},
+		{"rpc option field", `message Req {} service Other { rpc Get(Req) returns (Req) { option (co.opts.http).get = "/"; } }`, false},
+		{"file option", `option (co.opts.file) = "x"; message Req {}`, false},
+		{"fully qualified", `message Req { string name = 1 [(.co.opts.rule) = "x"]; }`, false},
+		{"relative to the package", `message Req { string name = 1 [(opts.rule) = "x"]; }`, false},
+		{"built-in option only", `message Req { string name = 1 [deprecated = true]; }`, true},
+		{"no options", `message Req { string name = 1; }`, true},
+		{"not the option", `message Req { string name = 1 [(co.api.rule) = "x"]; }`, true},
+	}
+
+	config, err := defaults.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SuppressOutput()
+
+	dir, err := ioutil.TempDir("", "protodot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, text := range unusedFixture {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			source := filepath.Join(dir, "svc.proto")
+			text := "syntax = \"proto3\";\npackage co.api;\nimport \"opts.proto\";\nimport \"types.proto\";\n" + c.body + "\n"
+			if err := ioutil.WriteFile(source, []byte(text), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			pbs := NewPbs(s)
+			pbs.inMemory = true
+			pbs.report = reportUnused
+			pbs.AddWriter(ioutil.Discard)
+			if err := processRoots(pbs, []string{source}, ""); err != nil {
+				t.Fatalf("failed to process: %v", err)
+			}
+
+			reported := map[string]bool{}
+			for _, one := range pbs.unused.Imports {
+				if one.File == source {
+					reported[one.Import] = true
+				}
+			}
+			if !reported["types.proto"] {
+				t.Errorf("the unused import of types.proto is not reported: %v", pbs.unused.Imports)
+			}
+			if reported["opts.proto"] != c.unused {
+				t.Errorf("opts.proto reported as unused: %v; want %v", reported["opts.proto"], c.unused)
+			}
+		})
+	}
+}
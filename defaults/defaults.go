@@ -0,0 +1,23 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package defaults embeds protodot's config.json and the templates it refers to (see assets.txt).
+// importing it makes them available as the assets (see support.LoadConfig, plus.PreloadTemplates).
+// regenerate staticAssets.go whenever any of them changes:
+//
+//	assets -src assets.txt -root . -output defaults/staticAssets.go -package defaults
+package defaults
+
+import (
+	"github.com/seamia/tools/assets"
+	"github.com/seamia/tools/support"
+)
+
+// ConfigName is the name of the embedded configuration file
+const ConfigName = "config.json"
+
+// Config loads the embedded configuration: the templates it refers to are the embedded ones too
+func Config() (map[string]interface{}, error) {
+	return support.LoadConfig(assets.AssetUriPrefix+ConfigName, false)
+}
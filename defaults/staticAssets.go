@@ -0,0 +1,549 @@
+// *** DO NOT EDIT ***
+// This file was generated by github.com/seamia/tools/assets/cmd/assets
+// on Friday, 16-Oct-26 07:29:16 UTC
+package defaults
+
+import "github.com/seamia/tools/assets"
+
+var staticAssets = assets.AssetRoot{
+	"config.json": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xa4ZM\x8f\xe4&\x10={~\x85e\xed1\xe9\xde\xefh\xfb\x96C\x94=$\xd9(JN\xd1jD\xdb\xd5n4\x18\b\xe0\xf9\xd8\xd1\xfc\xf7\b\x036`h۽s\x8a\xfa\xd5{T\x15\xe5rU\xbc\xcf7Eհ\xba\xef\x80*\xa40\xa3ա\xac\xceJqy\xd8\xef[\xac\xce\xfdqW\xb3n/\x01u\x18\xed\xb9`\x8a5LU?\xdc\x14\x95\x04\xa50meu(\x9fo\x8a\xa2b\x02O*EQ\xfd\xf6\x976+*\xca\x1a\xd8\xc93\xe20\xfc\xcc\t\xc2T\xc1\xa3\xf2\xd0\x13\xa3j'\xf17mQ\xbdy\x1d#\x14u\x03\xf2ϱ\xa7\xaa\x1fPm\xa0Ev\x88\xe0\x96\xee\u0380\x1a\x10\xdaF\xe0\xf6l\xa5=\\\xc2\x7f=\xd0\x1a\xf2\x16\xee\f\x02\xa79\xa8\x9e\xf8\x05\xaa\x00\x0eH\xe5\xf1{D\xfa\xbc6\xa3\xc0N\x1e:\x86\xce\x05\x9c\xf0㐳?X\x03\xb7\xd5M\xf1\xa2\xf3\xae\xa0\xe3\x04)\x18\x13\xef.\xd0\xcb\xc2\t\x138\x8c\x86\xfb#\xb4\x98\xeeTǉ9\x1e\xa8\x12O\x83rd8\x00\x9e\xe1(}bL%\xa5\x816\xce^\x13$\x88{\\{\xce\x1b{F\x81`\xea\xf3\xac\xe1\xad1\xf4\x8et\n\x82ד\x87y\xbe\xe0u\x82,\xfb\x93\x7f\xfc\x9cf\f|\xcfk\xd2K\x05\"\xf6ܣ\xf6\xc7V ~\xbe\x8d\xb3\xe9\x98.\xabYb\x9c]G\xcc{;1\x83,S\x90\n\x9a\xbc\xab\x06\x9f9ji\xd9\xe3,-:\xec$X\xb7Slׁ\x94\xa8\x85\x04O\xb1[\vz\x879\x1aоK\x95\x9ab\xb7\x1aJ0:,%\xa6m\xe6 \x03\xa6\x0ezT@\x9b4\xcb`\t\x92\t9M\xb2\xe9\x1aI\x9a\xe7\xe2\\\xacok8\xafo\xebʢ\x80\xb1\x9b\xf3\x9d\a\x03.1\xa3r\x85\x17\x93qBI\x80~*\xa0Y\xa1\xe3L\x13*٪r\xdc\xf9#g\x9e\a\x89;N\xe0ҳ>\xd8\xdd\x1a\xbb\xb8\x8bE\xf5\x95#G\xa5f\xa8qE\xe7\xc8\xf3\xe2\xb6\xfc\xa8P\xb3\xfc\xb8f\x8dD\xdf\xf9=>\xab\xd0w\x89\"\xd2䨋g\xb8q\xd3\x19\xa8\xe3e%\xfay\xdf%\xae\xaaC|\xc5Eu\x88ϯIS\x97.I\x13\xa3+Ҵ\xe9\x82.2\xe7\xf73\x90\xc7۹L\x0e/G\xb3\x87W\xb2I\xef\xf2s:\x18\x9b,\xcf\xef\xc9W\x1aӷFi\x96E_\xc9fs\x8dN\x94T_e\xb9\xfa}\xa1y\x8e\x03\xad\xc5'!К\xf5\xf0 Oa#Y\xc8SP\xa9\xe6\xc9\xc2\x1dgB\xc9\xf5\xe3\x90#\xe8\xb1+\xf5L\x18\xfcV\xc3\x19օw\x96GN\x04\xeeDjF)\xd4vv\xceH\x10L\xef\x12Ե\xa3\x19G\xf5\x1dja\f36\xb7x\x1c\xe6H\xbb\xe8\xa2#\xfb>j\xb6\x8b8\x97Z\x8bǇ\xf6\xb4\x97\xd0dY\x06\x8eI5#\x04q\x8f\x97+\xa0\xd1p\xae\xd0u@U\xeaH\v\x19\xe3x\x12\xdfq\x82\xa8\xea;\xb2~$w\x8c\xf5\xb3\xf9\xc8\xd8>\xa4{\xd45\xd3z\x82\xb8m\\\xbf \xb0q^\x9f\xf1\xaf\x18\xdc'\x8dk'\xf8\xb9\xc2\xe6Q>%\xb1i\xa6\x1f\x05\xae\x1c\xeec\xfe\xe6)\x7f\x14\xb8j\xdc\xf7ٛ\xe7\xfe\xe0\xe8\xed\v@p\xf6\xe6M\xc0goX\t\x12\x02\x1bw\x82\x84\xc2\x15K\xc1\x05?\xb6m\x05\x17\x84\xaeY\vf\"k\xf7\x83\\G\\\xb7 d٫6\x84,{튐\x17X\xbd#$%\xd6.\t9\xf2\xc6-!$\xaf[\x17RW\xbfn_\xc80\xd7,\f\x19\xeaʍ!\xc7\u07b42$D\xae\xdd\x19\x16\xa4\xd6/\r\vB\x1b\xb6\x86\x05\xa5mkÒؖ\xbda)W\xd7,\x0e١mi\x83\x18\x89ߵJ\xa4T\xae\xdd)b\xad\xad\xcbEn\xa4\\\xdc2\x1c\xef\xfb֍Xe\xeb\xde1=\xcfW- #}\xd3&\x92\xe0_\xb3\x8ax\xe4\xf9NR3\xc2\xc4\xf8i\xe0\x88\xea\xbbV\xb0~\x18\x8b\x8a\xea\xe1\x8c\x15L_ \xf4oEu$\xa8\xbe\v\xa7\u0600V5H\xdc1\x82\xef\xa1\x15\x00\xf4Mh\xeat|\x19\x01d\xf8\f$\xcf\xd8o\xc9Y\x13ך\x06}\xff\xc1\x0e\xfd\xe0\b\vh>\x1d>\xda\b\x9e8\xf8/\x19\a\xbf\xf6\xe0\xf1=\xe2\xc0\xb7\x1e\xe8\xbf,\x1c\xfe\xdeǽ\xd7A+\xe0I~:\xbc\v\x87\x9bп\x13a\x02\x11/\xc7\xcel\xea'\xee\x98\x0f\xde[6\x1d\xe4[\xcfb\xe4O\xf0\xbbp\x7f\n5ZF\x9a\x10\x17\xa0z1<H\xb5\xf61\x04'\xf7<0\xbcé%\xd9D\xd8\x00,\xe0IX\xfc}\x88G\xfe\x19\x9b\xb7\xc1\xf0;O\xd2O\x01\xbe\x90ko\x96M\x1e\xf6ƅe'\xd5K\x1e\x85\xc59n\x15\x91_\x81մ=X\xa9\x8f~\x1f\xdd\xf1\xfeHp\x9d\xb8_\x8b?\x00\xba\xf3\xeb\xecC\x80\xd6O\xb5\xadq\x01\x8d\x1c\x1d\xb0=\xea\x8c\xdb3\x19><\x1e\x9c\xc1\x87\xb8\re\xa2\xb5=\x83\xb0\xdaD\xe2\xdaư\xa0\xde\xe3oա\xd4\x7f\x95\xfd\xe4[T-P\x10h\x88\xb4,\xaaWϟ\xbf\xfc\xfe\xcb\xcb\xf8Yx?\xc1\xb6\xc7L_*\xb5u\xcd\xe8\t\xb7\xbb\x06\x8b\x17\xf7\x7f.\x1e(a\xa8\x91i\xb9\t\x1e\xac%\xebEm\xc5\xcc/Ⱦ\x1fJ\xf3W\xb9\x80\x187\xe1\x946\x1eD\b{(m1\x96\xf6\xddV\x1d\n%z0\xd2g\x0f\xd7\x0f\xbfF'\xd8\xc5U\xee8mKݎ5|BDƸ\xbc\x9f\xf0\x91N\x91\xc2\xf7\x16\x14@\x1b\x10 B\x81\xe1|\xdb\xcee\x89)\xc1\x14\x92\x16T!L\xb5U\tM\vA\f\xb6#\x97\xa6\x14\xa7(&\x8d\xb1RJS:\x81\x93\xe6\xd0\x12\x1ek\xd27\x1e\x7f\xa2ר>Cɑ\x90\xd0\fA\x86I\x92=\xe7\x02\xa4,\x11!%\xeb\x15\xef\xd5x\xbc\xbd\x17L\aq}\x85\xff\xde\x14\x89\x1b\x1f\xfe\xa3\xfe\xf1\xdd\xee\xe3\xee\xf5\xdeZ\x9b\xab~\xf5\xfc\xeb\x97?\x7f\xfe\xfb\xf3\xcb^\x8a\xda\xff\xa7\t-k\x99\xe1\x1d\xfbS¶\xba)\xbe\xea\xb3m`\xe6\xe8\xaf7/\xff\x0f\x00\xde*\xa6U\xfb \x00\x00",
+		Mime:  "application/json",
+		Mtime: 1792135756,
+		Size:  8443,
+		Hash:  "dc4a307348385470309a61ce37c537eee502894c5ee558d2e5dea0968041b87c",
+	},
+	"templates/begin.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x8fAj\xc40\fE\xd7\xd6)\x84\x97\x81&\xfb\x19r\x87B\xe9\xaat\xa1\xc4j\xc6L\"\x05[Y\xb4\xc1w/\xce0tJ\xbb\xfc\xff=\xf1Q׀\v\x8a\xa2\x86\x1c\xa2\x9d\xc0\xd1f\xfa4\xb1p\"\xe3\x80\xc3'N\xd1.\xdbЎ\xbat\x99i\x89ԭIM\x83\x1a4\x1d\x848%Z/x\xefp\ap]\x83+\x8dW\x9a\xf8\x84\x88\xfb\xde>\xdfR)\xd8t\aκ\xa5\xf1\xa0\a\xae\xc7Bˣ\xc03\x8f\x16UNUx\xb9\xa7\x9b\x00.\x91\\CL\xfd\xbeg6\x8b2e\xf4\x9a\"\x8bQ\xd5|)gp3\r<\xf7\xfeqߟ\xc1\x99\xealq\xfd\v\x86i\xd4YS\xef-\x91\xe4\x95\x12\x8by\x00'\x1a\x18\xdf\xc0\xb9|\xa1\x95\x7fmV\xd4\x1e\xb5/\x05\x9c\xfbP\xb1\x1c\xbf\xfe\x91*i+\xfa\x11\xebǽ\x7f\x1d6\xb1̓{?\x03|\x0f\x00\xc3\x0eb\xff\x91\x01\x00\x00",
+		Mtime: 1549992089,
+		Size:  401,
+		Hash:  "ee928961bafd7c9ded8820a6bb9c809e12399380b3af33b262e23de8a515726a",
+	},
+	"templates/comment.tmpl": {
+		Data:  "\n\t/* ------ {{.}} ------ */\n",
+		Mtime: 1549992089,
+		Hash:  "22a6c49aed98783315753d0eb1714e669330e11aa00ed4159c81b27f29622a97",
+	},
+	"templates/end.tmpl": {
+		Data:  "\n\t/* {{.AppVersion}} on {{.Timestamp}} */\n}\n",
+		Mtime: 1549992089,
+		Hash:  "5e7547f685a35373d38045f3972b919a027ba43e12da2d150c821886888c375d",
+	},
+	"templates/entry.tmpl": {
+		Data:  "\t{{.}}\n",
+		Mtime: 1549992089,
+		Hash:  "e155984e753fbba5e473ff59ee677586bf33e85b39d6c1317900cba3da4b4d90",
+	},
+	"templates/entry_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91Ao\xe20\x10\x85\xcf\xe4WX\xb3\x97\xddK8\xecձ\xc4\u0096\"E8\n\xfe\x03V2\x80\xa5\xc4N\x13G\x05Y\xf3\xdf+\x876\xa2\xea\xa1\xf4\xe8\x91ߛ\xef\xbd\xe1\xaa\x14ɂ\xab\r[\xe5\xbb\xed>\x83\x10\x06\xf4\xde\xd8\xd3\xc0\xc0\xe3ŧ\xba1'\x9b\xf6ء\xf6@\x04\"\x84\xb4\xe8\xf1h.D|\xa96\x0f\xc9\a|\x19\xd1V\xf8a \xfb\xdaX\xdd\xfc\xc0\xc1\xeavR\x87`\x8e,]\xbb\xb6E\xeb\x89\xd8s\xf9\xff)\x83_\xc0\x94\x94\xb9\xda\x15\xd1\xe0\xec\xdb\xe6\xee\x0f\x84\x80\xb6&\x127\xed\x06\xbb\x1e+\xed\xb1&\xe2\x87H\xb3\xd7-F\x94\xf8\xc0f@\xa2y\xf8.\xbd\xc3\xfc\xb7]\xcb\\\x96qO\xe5\x1a\xd73\xf0\xd7\x0eS\xb4c\x1b\xf9X!K\x95A\xe7f\v\xf8&Y\x94O\xbd$\x8b\x05\x1f#\x8f\xbav\x13\xcf\x18WN\x9b\xf92\xdei\xe2\u05f6\x9e\xb3\xb1߮\xf3\xc6Y\x06\xc3ٽ\xb2\xea6\x1d\x98\xb1\x8d\xb1\b\x7f\x88\x92\xf9\xc0\xe2.\xc3Z\xe6\x87b\xb5\xcf\xe0/<X\xbb\xe0F|)\x96/\x8d\xf8\xcc7\x95\x95\xbc\r\x00\xbcy\xd5\xdcU\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  597,
+		Hash:  "0fdcfb433f93fe76c6c6a0ed26153e244e38a08e9b59c266adf0661906747a75",
+	},
+	"templates/entry_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91Ao\xe20\x10\x85\xcf\xe4WX\xb3\x97\xddK8\xecՉD\xa1\xa5H\x11\x89\x82\xff\x80I\x06\xb0\x94\xd8i\xec\xaa k\xfe{e\xd3FT=\x94\x1e=\xf2{\xf3\xbd7\\\xd4y2\xe3b\xc5\x16\xc5f\xbd\xcd\xc0{\x8b\xce)}\xb4\f\x1c\x9e]*;u\xd4\xe9\x88\x03J\aD\x90{\x9fV#\x1eԙ\x88\xcf\xc5\xea.\xb9ŗW\xd4\r~\x1a\x94c\xab\xb4\xec~\xe1\xa0e\x1f\xd5ޫ\x03K\x97\xa6\xefQ;\"\xf6\\?>e\xf0\a\x98(\xcbBl\xaa`pr}w\xf3\a\xbcG\xdd\x12\xe5W\xed\n\x87\x11\x1b\xe9\xb0%\xe2\xbb@\xb3\x95=\x06\x94\xf0\xc0\xce\"\xd14\xfc\x90\xde`>\xac\x97eQ\xd6aOc:32p\x97\x01\xd3\x1e\xad\x95ǈȪ\xb2\x16\x19\ffr\x81\x1f\xc2\x05\x87XM2\x9b\xf1}@\x12\x97!\"\xed\xc3ָ\x9c\xcféb\x04\xa9\xdb)\x1e\xfbk\x06\xa7\x8cf`O\xe6\x8d5שeJwJ#\xfc#J\xa6\x1b\xe771\x96e\xb1\xab\x16\xdb\f\xfeÝ\xcd\xe7\\\xe5ߺ\xe5s\x95\x7f\xe5\x8b}%\xef\x03\x00l\uebffX\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  600,
+		Hash:  "03782d560d5ed0d3d7cb612acf1da64ca7fc89de981089625a24807f171c42ad",
+	},
+	"templates/entry_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91Ao\xe20\x10\x85\xcf\xe4WX\xb3\x97\xddK8\xecՉD\xa1\xa5H\x11\x8e\x82\xff\x80I\x06\xb0\x94\xd8i\xec\xaa k\xfe{\xe5\xd0FT=\x94\x1e=\xf2{\xf3\xbd7\\Vy2\xe3r\xc5\x16\xc5f\xbd\xcd \x04\x87\xdekst\f<\x9e}\xaaZ}4\xe9\x80=*\x0fD\x90\x87\x90\x96\x03\x1e\xf4\x99\x88\xcf\xe5\xea.\xb9×W45~\x1a\x88\xa1\xd1F\xb5\xbfp0\xaa\x1b\xd5!\xe8\x03K\x97\xb6\xeb\xd0x\"\xf6\\=>e\xf0\a\x98\x14\xa2\x90\x9b2\x1a\x9c|\xd7\xde\xfc\x81\x10\xd04D\xf9U\xbb\xc2~\xc0Zyl\x88\xf8.\xd2lU\x87\x11%>\xb0uH4\r?\xa47\x98\x0f\xeb\xa5(D\x15\xf7Զ\xb5\x03\x03\x7f\xe91\xed\xb4s\xda\x1c#\"+E%3\xe8\xed\xe4\x02?\x84\x8b\x0ec5\xc9l\xc6\xf7\x11I^\xfa\x11i\x1f\xb7\x8e\xcb\xf9<\x9ej\x8c\xa0L3\xc5c\x7fm\xef\xb55\f\xdcɾ\xb1\xfa:uL\x9bV\x1b\x84\x7fD\xc9t\xe3\xfc&\xc6R\x14\xbbr\xb1\xcd\xe0?\xdc\xd9|\xceu\xfe\xad[>\xd7\xf9W\xbe\xb1\xaf\xe4}\x00\x1a\xa7(\x8eX\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  600,
+		Hash:  "71cc4173c9ef3a2fefa4a16b58f6180c0552c07902813d16bfd8bb1ea05fbba3",
+	},
+	"templates/entry_simple.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91\xc1n\xf20\x10\x84\xcf\xe4)\xac\xfd/\x7f/\xe1\xd0k\x12\x89\x02\xa5H\x11\x89\x82_ J\x16\xb0\xe4\xd8n\xec\xaa k߽\xb2i#\xdaK\xe9ѫ\x9d\xf17;\x19o\x8ad\x96\xf1\x15[\x94\xdb\xcd.\a\xef-:'\xd4\xd12pxvi+\xc5Q\xa5#\x1al\x1d\x10A\xe1}Z\x8fx\x10g\xa2l\xceWw\xc9-\xbe\xbe\xa1\xea\xf0ˠ\x1a{\xa1Z\xf9\a\a\xd5\x0eQ\xed\xbd8\xb0t\xa9\x87\x01\x95#b/\xcd\xfa9\x87\x7f\xc0xU\x95|[\a\x83\x93\x1b\xe4\xcd\x0ex\x8f\xaa'*\xae\xda\x15\x9a\x11\xbb\xd6aO\x94\xed\x03ͮ\x1d0\xa0\x84\aJ\x8bD\xd3\xf0Sz\x83\xf9\xb4YVeՄ\x7f:-\xf5\xc8\xc0]\f\xa6V\fFFBVW\r\xcf\xc1\xe8\xc9\x04~\xc9\x16\f\xa2\x92oy\xb9\x0ek)\xbf\x98 ,\x92\xd9,\x13\xc54\xc8\xe6\"`D\x9al\x1e\xba\x8b\x99Z\xd5Oy\xd9\x7fm\x9cЊ\x81=\xe9w\xd6]\xa7\x96\t%\x85Bx J\xa6ҋ\x9b\\˪\xdc\u05cb]\x0e\x8fpg\x15E$\xfbq\xec@\xf8\x9d/\x1e0\xf9\x18\x00TG\xc7\ai\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  617,
+		Hash:  "f155d682bdde05609b3e83d99bb2291edcce4d7b5c505862ea46508c0e289004",
+	},
+	"templates/enum_entry.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xac\x90OK\xc40\x14\xc4\xcf\xed\xa7\b\xf1\xa2\x97\x14<g\x03\xebVׅ\xd2.\xdd\xe2=\xb6\xcfn0\x7f\x966U!\xbc\xef.ieW\xf1\xe2\xc1\xe3\x1bf\x92\x99\x1foj\x91&\xbc\xc9\xc9\xddvS\x15U\xbd\xa2!\xb4N\xbb\x81P\xb0\x93aϲ}\xed\a7َ\"R\xb2.v\xdb2zF\xf0^\xd9~$\xd4ÇgR\xab\xde2+\rD[\bꅰ\x8d3\x06\xacG$\x8f\xf5\xfdÊ^Q\xd2TU\xd1\xec\xf6\xf1\x81\xa37\xfa\x9b\x87\x86\x00\xb6C\x14i\x92,\xf1\x1cN\x03\xb4\xd2C\x87\xc8\x0f\"\x04VJ\x03\x88<\x8b\a\xe8\x11\x10\xcf\xe2W:Mx\xd6\xe4\xff\xb8\xe8M\xeai\x9e\xb4\xf4bO\xf1\xbe\xfcó\xc8o\xee+mw\x9eC\xae\xdd\xc9+g\t\x1d\x8f\ue774\x8b:\x12e\xb5\xb2@o\x10\xd3\v\xf8MU\x1c\xf6\xebrEo\xff\x8aWp%~\x01\xe4\x99\x12?K\xcdD>\a\x00\xef\xe7+p\xe1\x01\x00\x00",
+		Mtime: 1792132491,
+		Size:  481,
+		Hash:  "d5283dad5ebbb8af20159745556bfab12537f6b8873e905ec8462d83a769d7f7",
+	},
+	"templates/enum_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x91A\x8f\x9b0\x10\x85\xcf\xf0+\xac9\xb5\x17h{6H\t\x89\xa2H\b\"JO\xedńI\xb0j\xc6,8\xdaH\x96\xff\xfbʰ\xc9F\xbb\xda=>\xcfx\xe6{o\xac\x9d\xd0\x18I\xe7\x89\x01\xe9\x16\xa3aē\xbc\x82s\xd6F\x7fH>]й\xe0\xefԉ\x01\x93A\tI\x06\xaf\x86\x19\xad\x95\x91C\x02\xd6F\x85\xe8ѷ\xcb\x13\x8b2\xdd\xf7Hƹ\x7fdmgz\xf5\xf0d-R\xeb\x1c0%\x1aT\t\x0fy\xbdZ\xe7[\xb6.\xabͶJ\xe0'\xb0l\x9b\xe77\xf9c\x91\xbf\x0f\xabl_\xecf\xbd\xdeee^V~\xebQ+=2@\xba\xf4Q#\x8e\xffϣ\xbeP\v\xceA\x1a\x06\xbc\xae\xd20\bx\xbdaY\xe9'\x14\t\xfc\x02v(\xab:\x81\x0eE\x8b\xe3\xa7\xc3^˞s\x95\xefw\x85\xaf\xbfE\xe4\xcdGB\xc93=4\xfa]\x81\xff\xccx\x93\xde\x03\xe1q3C\xc4\xf5\xc6#Şi\x0eIP{O\x85}Ӄ\x91\x9a\x18L\x9d~f\xc7\xe5ub\x92\x94$\x84\xef΅\x8b\x99\xf7^\xbef#\xd1\xe3L\xc6e\xfa\xe1\x0e<\x96\xe9Bu\x83\x9a\xef\xf22\x00X\xee\x1f\xa1\n\x02\x00\x00",
+		Mtime: 1792132191,
+		Size:  522,
+		Hash:  "5fc81d7af6ca2749e30d3a19925406130397c090b24e01caced0af07f1cb0ed2",
+	},
+	"templates/enum_suffix.tmpl": {
+		Data:  "</TABLE>>];",
+		Mtime: 1549992089,
+		Hash:  "02177f2768fe5a0168d8d94e179b521d5164217e37a36a35f5215946b30d7583",
+	},
+	"templates/import_link.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84Ͻ\x8a\xc30\x10\x04\xe0\xda\xf7\x14\x8b\xfaSq\xed\xe14\x814iӅ\x14\x8e\xb4\xc6Kd\xafb+\x18\xb3\xec\xbb\a\xd9&\x10\xc8O\xa5\x11\x9a\xf9@\x85\x88\xdd\xf5ܪ\x16\xbf\x1b\x10\xb1\aV\x85cb\x0e\x89biD\xa8\x06\xbb\x9d\\@Uj#\xf7\t\\\xbe\x89`\x18PU\xc4\xee\xa9\xf39`>\x9e\x17\xe08p\x9f\x999\x80Y\b;\x13F\xd5@\xc4n$\x9f\x9a\xf2o\x11\x81j\xc0+\xcc(\x98x;\ar\xe6=\xf4(|\x93F\xac.\x1f\x9c\xf5٬\xbf\x10y9\x1e\xd2\x14\xb0\xf4\xd5Р_\x9b\xa7\xff\x9f\xfb\x00\xc5wSiD\x01\x00\x00",
+		Mtime: 1792132859,
+		Size:  324,
+		Hash:  "63d26e1fcf94441d589b44449a1db2e22c6f948524f413cef01aed03290370f4",
+	},
+	"templates/package_node.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffdʱ\n\xc20\x10\x87\xf1\xb9>őIA\xf2\x00JV\xc7\xe2.\x0e\xd7\xf4\xaa\xa1\x7f{\xa5\x97E\x8e\xbc\xbb\bn\xae\xdf\xf7\xeb\xdcc\xaf\xa3\xf4\xfc\x92ֺ\xeefO^%U\x1e\b<\bRp\x8fW\xce3?~&PUE-\xeb\xff:\x91;d\xa1x)\x10k\x8d\xa6\x02\xd9\xdb!\x90\xd57$M\x05\x90\xf1\xf8\xcd\xc8\n\xddR\xd6m\xb1\x82\xf9~\xde}\x06\x00\xa78\xc2\b\x8a\x00\x00\x00",
+		Mtime: 1792132904,
+		Size:  138,
+		Hash:  "cbfd3421f9119a56619ee946d79f25947041139b236fcf4fb8ccee8c63925816",
+	},
+	"templates/package_link.tmpl": {
+		Data:  "\t{{.From}}\t-> {{.To}} [label=\"{{.Count}}\" tooltip=\"{{.Count}} type reference(s)\"];\n",
+		Mtime: 1792132904,
+		Hash:  "b73c305a3922ec56b560e66f92a686329b8a7d2cf34550337930e65a9fc2785d",
+	},
+	"templates/import_node.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff⬮\xd6\xf3\xcbOI\xf5K\xccM\xad\xad\xe5\xe4\x8c.\xceH,H\xb5\xcd\xcb/IU\xc8ILJͱU\xaa\xae\xd6\vHL\xceNL\x87*\x8aɫ\xae\xd6s\xcb́r\x95\x14\x14J\xf2\xf3sJ2\v0\x95*)\x14\x97T\xe6\xa4ڦe\xe6䤦\xe8(\x80\xe8\xe4\xfc\x9c\xfc\"\xdb\xe4\xfc\xa2\xbc\xe2̜\xecXk.\xc0\x00Z\x18\xe1_\x83\x00\x00\x00",
+		Mtime: 1549992089,
+		Size:  131,
+		Hash:  "b79f7553fd623903681103af546dbb14d5f68fdd929de42cbca9adfe972b5e5f",
+	},
+	"templates/import_node_missing.tmpl": {
+		Data:  "\t{{.NodeName}}\t\t[shape=note label=\"{{.FileName}}\" tooltip=\"{{.FileName}} is missing\" style=filled, fillcolor=lightpink];\n",
+		Mtime: 1549992089,
+		Hash:  "f94182f31445b27215adb01d7e58df5a65f79d83c3d6d36610135a218f89d9fb",
+	},
+	"templates/map_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91\xdfj\x830\x14Ư\xf5)\xc2\x19\x94\r\x86^\xec\xb21е[W&U\xac/ z\xda\x064q\x1aYK8\xef>\xa2\xa3\xeb\xd8Mw\x99\xf3\xe7\xcb\xef;\x1f\xcf3\xe1{<_\tk\x83\xb4ý<\x11\xf10_MU\xb6\x887\xebm\x04\xd6\xf6h\x8cT\x87\x9e\x81\xc1\x93\t\x8aZ\x1eT\xd0\xe3ǀ\xaaD \x02'\x90t\x95TE\xfd\x0f\x05U4㶵rς\xa5n\x1aT\x86\x88\xbde/\xaf\x11\xdc\x01˓$\xce7\xa9\x138\x9a\xa6\xbe\x9a\x01kQUDb\xda]a\xdbaY\x18\xac\x88\xf8\xce\xd1l\x8b\x06\x1d\x8a{`\xdd#ѥ\xf8\xbdz3\xa69\xb7#&{^/\x938\xc9\xdc`\xa9k\xdd1p\xad\x00\xd5Ќ\xfd4\xc9\xf2\bZ}\xf9\t\x84\xefyM\xd1\xcej3\xb76x\xc7s~n\x91\xe8\x91\xf1\xc1AN/\x1e\x0ebv0sߛ\x90x\xe8r\x19\x8d\x15\xaa\xba\x98f\xf7\xba5R+\x06\xfdQ\x7f\xb2r\xaa\xf6L\xaaZ*\x84\a\"\xff'\xd0+s\xcb$ޥ\x8bm\x04Opc\x1e\x82K\xf1\xe7\xe2<\x94\xe27\xdfxE\xffk\x00\x84\xfb\xb8\x85E\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  581,
+		Hash:  "fbcd77a623e481ef7a939916ba4cad778f312d4117c115ad3c441d4c7c9063c9",
+	},
+	"templates/map_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91Aj\xf30\x10\x85\xd7\xf6)\x84~\b\x7f\xa1؋.#\vҤMCMl\x1c_@\xb1'\x8e\xc0\x96\\K\xa5\tb\xee^d\x974\xa5\x9bt9\xa3\x99\xa7\xef\xcdce\xc1À\x95+\xee\\\x94\x0fp\x90'D\x16\x97\xab\xa9K\x16\xe9f\xbdM\xa8s\x06\xac\x95\xaa1\x84Z8\xd9H\xb4\xb2Q\x91\x81\xb7wP\x15PD\xea\x05\xb2\xa1\x96J\xb4\x7fPP\xa2\x1b\xb7\x9d\x93\a\x12-uׁ\xb2\x88\xe4\xa5xzN\xe8?J\xca,K\xcbM\xee\x05\x8e\xb6k\xaff\xa8s\xa0jD>\xed\xae\xa0\x1f\xa0\x12\x16jD\xb6\xf34[сG\xf1\x05\xb4\x06\x10/ͯ՛1\xed\xb9\x1f1\xc9\xe3z\x99\xa5Y\xe1\a+\xdd\xea\x81P\xff\x14u`\x8ch\xa6\x91<+ʄ\xf6\xfa\xf2\x19\xe5a\x10t\xa2\x9f\xb5v\xee\\\xf4\n\xe7\xf2\xdc\x03\xe2=a{\xcf9U,\xde\xf3Yc\xe7a0Q\xb1\xd8G3z\x13\xaa\xbe\xf8&\xffuo\xa5V\x84\x9a\xa3\xfe \xd5\xd45D\xaaV*\xa0w\x88\xe1w\xa6W\xfe\x96Y\xba\xcb\x17ۄ>\xd0\x1b#\xe1L\xf2_Gg\xb1\xe4?\xf9\xc6C\x86\x9f\x03\x00ȋ\xfa\nH\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  584,
+		Hash:  "7e3289e65a341aeea7330c5e2ff77685b2aa43e4cec4901bb8e5fabb2a289886",
+	},
+	"templates/map_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91\xd1j\xb3@\x10\x85\xaf\xf5)\x96\xf9!\xfc\x85\xa2\x17\xbd̺\x90&m\x1a*Q\x8c/`tb\x16t\xb5\xee\x96&,\xf3\xeee\xb5\xa4)\xbdI/gv\xe6\xecw\xe6\xf0<\x13\xbe\xc7\xf3\x95\xb06H\a<\xc8\x13\x11\x0f\xf3\xd5\xd4e\x8bx\xb3\xdeF`\xadFc\xa4\xaa5\x03\x83'\x13\x14\x8d\xacU\xa0\xf1\xed\x1dU\x89@\x04N \x19*\xa9\x8a\xe6\x0f\n\xaah\xc7mk\xe5\x81\x05ˮmQ\x19\"\xf6\x92==G\xf0\x0fX\x9e$q\xbeI\x9d\xc0Ѵ\xcd\xd5\fX\x8b\xaa\"\x12\xd3\xee\n\xfb\x01\xcb\xc2`E\xc4w\x8ef[\xb4\xe8P\\\x81\x8dF\xa2K\xf3k\xf5fLs\xeeGL\xf6\xb8^&q\x92\xb9\xc1\xb2k\xba\x81\x81{\nZ\xa9\xb5T\xf58\x92&Y\x1eA\xdf]>\x03\xe1{^[\xf4\xb3\xc6̭\r^\xf1\x9c\x9f{$\xbag|\xef8\xa7\x8a\x87{1\xab\xcd\xdc\xf7&*\x1e\xbahFo\x85\xaa.\xbe\xd9\xff\xae7\xb2S\f\xf4\xb1\xfb`\xe5\xd4\xd5L\xaaF*\x84;\"\xff;\xd3+\x7f\xcb$ޥ\x8bm\x04\x0fpc$\x82K\xf1\xeb\xe8<\x94\xe2'\xdfxH\xffs\x00\x91b\x8a\xd2H\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  584,
+		Hash:  "76b237922f96768933d348b76d330fea060e7d93acf862f50b23f7333fc0a3d4",
+	},
+	"templates/map_simple.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x91\xcfj\xe30\x10\xc6\xcf\xf6S\x88Y\b\xbb\xb08\x87=\xc66d\x936\r5\xb1q\xfc\x02ƞ$\x02\xfd\xab\xa5\xd2\x041\xef^d\x974\xed)=\xceh\xe6\xd3\xef\x9b/m\xea<\x8e\xd2f\x9d{\x9fT\x03\x1e\xf8\x99(\x9d7\xeb\xa9˖\xc5v\xb3\xcb\xc0{\x8b\xcequ\xb4\f\x1c\x9e]\xd2\n~T\x89ŗWT\x1d\x02\x11\x04\x81r\xe8\xb9j\xc5\x0f\x14T+\xc7m\xef\xf9\x81%+-%*GĞ\xea\x87\xc7\f~\x01kʲh\xb6U\x1089)nf\xc0{T=Q>\xed\xae\xd1\fص\x0e{\xa2t\x1fhv\xadĀ\x12\n\x14\x16\x89\xae͏ջ1\xddŌ\x98\xec\xfffU\x16e\x1d\x06;-\xf4\xc0 <%\x96K#\xa6\x89\xaa\xac\x9b\f\x8c\xbe\xfe\x05y\x1cE\xb253\xe1\x16\xde'\xcfxi.\x06\x89\xfe\xb2\x94\a̩J\xe7<\x9f\x1d\xdd\"\x8e&\xa8t\x1e\x92\x19\xad\xb5\xaa\xbf\xdaf\xbf\xb5q\\+\x06\xf6\xa4\xdfX7u-\xe3Jp\x85\xf0\x87(\xfe\x8c\xf4\xc6ު,\xf6\xd5r\x97\xc1?\xb83\x91|\xa4\xfbv\xf3@\xf9\x95o\xbcc\xfc>\x00\x14\xf6p\x0eG\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  583,
+		Hash:  "9c2828c411ff7e07b4d5a56b1de6e644e79d822feea55d1a53e37eebf5a2ebd6",
+	},
+	"templates/message_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x91Ao\xa30\x10\x85\xcf\xf0+,\x9fv/\xb0+\xed\xd1 %$\x8a\"!\x88Xzj/&L\xc0\xaa\xb1)v\xd4T\xd6\xfc\xf7\xcaдQ+\xe5\xf8FO3\u07fc\xe7\x9c\x01k\x85\xea\f\xa1J\xb7\x10\x8d\x13\x9cą\":\x17=(\xf1r\x06\xc4\xe0\xd1\xf4|\x84d\x94\\(\v\x17K\xac\xd6Ҋ1\xa1\xceE\xf5\xdb\b\xde.N$\xca\xf40\x80\xb2\x88Oʹ\xde\x0e\xf2f\xe4\x1c\xa8\x16\x91\x12\xc9\x1b\x90\t\vY\xbdZ\xe7[\xb2.\xabͶJ\xe8_J\xb2m\x9e_\xe5\x9fE\xfe?\xac\xb2}\xb1\x9b\xf5z\x97\x95yY\xf9\xabG-\xf5D\xe8\x00\xc6\xf0\x0e\xa2\x86\x1f\x9f\xbbI\x9fUK\x11i\x1a\x06\xac\xae\xd20\bX\xbd!Y\xe9\x97\x14\t\xfdGɡ\xac\xea\x84\xf6\xc0[\x98\xee\xed\xfbpx\xdaU\xbe\xdf\x15\xde\xf2\x15\x94\x8f \xe2Rt\xea\xc6\xe8\xcf\x05\xacI\x9d\x8b\n>\x00\"\x8b\x9b\x19!\xae7\x1e(\xf6DsJ\\\xb5\x9f\xb1\x90_z\xb4B+BM\xaf_\xc9q\x99\x1a\"\x94\x14\n\xe8o\xc4py\xe5\xfb'\xf7\xb1\x14\x1f`\x86b\"\xfdQ\x04\x8bE\xbaP]\xa1\xe6b\xde\a\x00<\xd2hQ\v\x02\x00\x00",
+		Mtime: 1792132191,
+		Size:  523,
+		Hash:  "60075819c33bb57226c5b86c07708a99461368acea0980765deacc953203787f",
+	},
+	"templates/message_suffix.tmpl": {
+		Data:  "</TABLE>>];",
+		Mtime: 1549992089,
+		Hash:  "02177f2768fe5a0168d8d94e179b521d5164217e37a36a35f5215946b30d7583",
+	},
+	"templates/missing_node.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8c\x90\xcdN\xc30\x10\x84\xcf\xf0\x14\x96\x1f \x853\x8e\xa5\xfc)\xaa\x14%U\b'\xc4\xc1m\x96d\x85c\x9bؕ\x8a,\xbf;JZ\xa0'\xe08\xd2\xec|3\xeb\xbd\x05\xe7P\r\x96P\xa5{\x88\xcc\f\xafx\xa2!x\x1f=)|?B\b7\xcfv\x14\x06b#\x05*\a'G\x9c\xd6ҡ\x89\xa9\xf7Q-&\b\x81\x12)\xf6 cƺ$\xad\n\x926m^\xb41\xbd\xa7$+\xaa\xeaKޝ\xe5\xe3.ɶu\xb9\xea\xb4̚\xaai\x97\xac\x83\x96z&tBkQ\r\xd1^\x1cކY\x1fUOC\xa0\x9cu-g]NvM\xdb\xc5t\x04\xd1\xc3\xfc\xdb\xf9űtK\xaamY/\x96\x9f\xb5ˎHH\x1cԕ\x91\x7f\xefa\x9b.\xe7l\xb3\"\xcf\xd8\xff\xf6\xfc\x03\xa6\xc4\x04+ʍh\x89\xfb0@ВK\xd4\x15u\xb3\xfe\x91\xf3\x97\x87\xdb\xcf\x01\x00-\xc0O<\xa5\x01\x00\x00",
+		Mtime: 1549992089,
+		Size:  421,
+		Hash:  "0db202cb54e15a4b4bf2cb2a3ae08c8d2ad2f5f06c54770b0da550df9b5f3385",
+	},
+	"templates/unused_node.tmpl": {
+		Data:  "\t{{settings \"node.prefix\"}}{{.Unique}}\t[shape=box margin=0 penwidth=3 color=\"{{color \"unused.highlight\"}}\"];\n",
+		Mtime: 1792132801,
+		Hash:  "bed69e2d7bc6a110320ae6aae310c9122eb888623ccdfc002e5fe7b8eb8765fc",
+	},
+	"templates/collapsed_node.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x90_K\xc30\x14ş\xdbO\x11\xf2*\xa4\xfa\xaai`\xff\x9c\x83\xb2\x8eZ\x9fć\xb4\xb9v\xc1,\xa9M\x06\x83p\xbf\xbb\xb4St(\xbe\xdd\x03\x87߹\xe7\xc4\xe8!\x04m;O\xa8u\nX?\xc0\xab>Q\xc4\x18ٓ\xd5\xefG@L\x9e\xfd^\xf6\x90\xf7Fj\x1b\xe0\x14Hp\xce\x04\xdd\xe74Fv\x7f4f+\x0f\x80xKbd\x0fZ)\xb0\x88d?\x1dDA\x0fV\x81m5xJ\x8cl\xc0\xe4<\xe5\xf5l^\xacȼ\xac\x96\xab*\xa77\x94,VE\xf1%\xaf\xcf\xf2q7[l\xb6\xebI\xcf\u05cb\xb2(\xab1\xb1u\xc6\r\x84\xb6\xce\x18\xd9{P\xac\x91\xed[7\xb8\xa3U\x14\x91\x8a4\xe1u%\xd2$\xe1\xf5\x92\xecʪ\xce\xe9\x1e\xa4\x82\xe1/\xca\x01\xbc\x97\x1d\xb0O\a\"%\xb3b\xb3ގ\x96\xefi\xc6\xd2L\x1a\xdd\xd9\x1f\xc61\"ፈ\x91\x9d\xfb\xf3\xac\x99b\xb3z9>\x91\xd5\xd5\xe5/\xff\x83\xad<\xc0\x84\xe5Z\\\xfd\x1e\x92gZ\\\x90y6m(\xc4\xcb]\xfa1\x00i.\xc8V\xc7\x01\x00\x00",
+		Mtime: 1792132992,
+		Size:  455,
+		Hash:  "eae5d2b1b3fadb12c17dd8c3290601882d35338c2ab4a6af728fff090dad1b0a",
+	},
+	"templates/oneof_entry_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8c\x91\xc1n\xfa0\f\xc6\xcf\xf4)\"\xff/\xff]\xcaa״\x12\x83\x8d!U\x14\x95\xbe@\xd6\x1a\x88\xd6:]\x9bjC\x91\xdf}J@\x15\xd3v\xe0\x18۟\xfd\xcb\xf7ɲH\xa3\x99,W\xe2i\xbd̳\xbcH\xc0\xb9\xca4\xa6\x17`\b\xcd!~S\xd5\xfb\xb17#\xd5\xc0\f\xa9\x9c\x97\xab\xabb\x91m\xd6[??\xa0\xb5\x9a\x8e\x83\x00\x8b_6V\x8d>R<\xe0ǈTaP9\x17\xe7}\xadI5\xccwo \xd5\x06\xb5s\xfa \xe2\xa5i[$\xcb,^\x8b\xe7\x97\x04\xfe\x81(\xf3<+7;\xbf\xe0d\xdb\xe6f\x06\x9cC\xaa\x99Ӌv\x85]\x8f\x95\xb2X3˽\xa7٪\x16=\x8a\x7f`3 \xf3T\xbcJ\xefƴ\xe7.`\xfe\xe1\xa0o\xc5Hc\x1b\xfa\xbb\xbc(\x13\xe8\xcct\t\xd2h6\x93\xa3\xe7)\xcf]\xe0\x19\xfd\xc9pY\xce}4\x81_Q=\xfdM\xfc7\x9dՆ\x04\f'\xf3)\xaaKu\x10\x9a\x1aM\b\x0f\xccє\xe9mX\xcb<\xdb\xef\x16\xdb\x04\x1e\xe1N\xdbS\xa9\xd3_\xc6ʹN\x7f\xf2\x05\xb3\xa2\xef\x01\x00\xbak\xdd\xd6H\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  584,
+		Hash:  "ded830c68a0485ef599ed1f45d49022f81334a68e56226bb81d17a6c0dce2fbf",
+	},
+	"templates/oneof_entry_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8c\x91\xc1n\xe20\x10\x86\xcf\xe4)\xac\xd9\xcb\xee%\x1c\xf6\xeaD\xa2\xd0R\xa4\x88\xa0\xe0\x170\xc9\x10\xac&v\x1a\x1b\xb5Țw\xaflPD\xd5\x1e8z<\xff\xcc7\xff\xcfE\x95'3.V\xeci\xbd,\x8b\xb2\xca\xc0\xfb\xdatfd`4\x9acz\x90\xf5[;\x9a\xb3n\x80\br>\x17\xab\x9bbQl\xd6\xdb\xd0o\xd19\xa5[\xcb\xc0\xe1\xa7Ke\xa7Z\x9dZ|?\xa3\xae1\xaa\xbcO˱QZvD\x0fOв\x8fj\xefՑ\xa5K\xd3\xf7\xa8\x1d\x11{\xad\x9e_2\xf8\x03L\x94e!6\xbb0\xe0\xe4\xfa\xee\xae\a\xbcG\xdd\x10\xe5W\xed\n\x87\x11k\xe9\xb0!\xe2\xfb@\xb3\x95=\x06\x94\xf0\xc0\xce\"\xd1T\xbcI\x1f\xc6t\x97!b\xfe\xe2`\xf8J{\xb4V\xb6ז]Y\x89\f\x063-\x83<\x99\xcd\xf8! \x89\xcb\x10\x91\x0eak\\\xce\xe7!\x9dx\x82\xd4\xcdt\x1e\xfbk\x06\xa7\x8cf`O\xe6\x83\xd5תeJwJ#\xfc#J\xa6X\xef\xf3Z\x96\xc5~\xb7\xd8f\xf0\x1f\x1et>\xe7*\xff\xe1-\x9f\xab\xfc;_\xf4+\xf9\x1a\x00\x10^\x8c\xefK\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  587,
+		Hash:  "4e880ca0ae51157428b91e9abaefb3bab3aa40c49355ec2e1d0c0a6a1b95fa48",
+	},
+	"templates/oneof_entry_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8c\x91\xc1n\xe20\x10\x86\xcf\xe4)\xac\xd9\xcb\xee%\x1c\xf6\xeaD\xa2\xd0R\xa4\x88\xa0\x90\x17\b\xc9\x10\xac&\xe346j\x915\xef^٠\x88\xaa=p\xf4x\xfe\x99o\xfe_\x96E\x1a\xcdd\xb9\x12O\xebe\x9e\xe5E\x02\xceպӣ\x00M\xa8\x8f\xf1\xa1\xaa\xdf\xdaQ\x9f\xa9\x01fH\xe5\xbc\\\xdd\x14\x8bl\xb3\xde\xfa~\x83\xd6*j\x8d\x00\x8b\x9f6\xae:\xd5Rl\xf0\xfd\x8cTcP9\x17\xe7c\xa3\xa8\xea\x98\x1f\x9e@U\x1f\xd4Ω\xa3\x88\x97\xba\xef\x91,\xb3x-\x9e_\x12\xf8\x03\xa2\xcc\xf3\xac\xdc\xec\xfc\x80\x93\xed\xbb\xbb\x1ep\x0e\xa9aN\xaf\xda\x15\x0e#֕ņY\xee=Ͷ\xeaѣ\xf8\av\x06\x99\xa7\xe2M\xfa0\xa6\xbd\f\x01\xf3\x17\a\xfdW\xdc+c\x14\xb5\xa1e\x97\x17e\x02\x83\x9e\x96A\x1a\xcdf\xf2\xe0\x91\xca\xcb\x10\x90\x0e~kX.\xe7>\x9dpBE\xcdt\x9e\xf8\xab\a\xab4\t0'\xfd!\xeak\xd5\bE\x9d\"\x84\x7f\xcc\xd1\x14\xeb}^\xcb<\xdb\xef\x16\xdb\x04\xfeÃΧR\xa5?\xbc\x95s\x95~\xe7\v~E_\x03\x00\xa7ʻ\xdbK\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  587,
+		Hash:  "2153055e4a0a232bc09b28faf248a6950a837f564a62b6132735ca0d1ab2b793",
+	},
+	"templates/oneof_entry_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84\x8f\xc1J\xc3@\x10\x86\xcf\xc9S\f\xe3E/\xe9A\x8f\x9b\x85\x9aj-\x84\xa4\xc4}\x815٦\x8b\xd9YiV\x14\x96yw\xd9\x14\x8aŃ\xd7៏\xef\x13\xaa\x93y&\xd4\x06\xaa\xb6~ݯ\x9b\x12\x1f\x10\x1e\xb7U[\xb7]\x891\xf6~\xf2'@O\xc6\x1f\x8a7ݿ\x8f'\xffI\x032#\xac\xebݶI\xa3ل`i\x9c\x01\x83\xf9\x0e\x85\x9e\xecH\xc5\xf2\x92v1\xda\x03\x14\x95w\xceP`\x86\x97\xee\xe9\xb9\xc4\x1b\x04ն\xb5\xda\xed\x13\xe1\x18\xdc\xf4k\x831\x1a\x1a\x98e\x9ee1\x16\x8dv\x869\xcf\xc4Jmd.VIz\xa1j\x1a._p\xeb?\x82\xf5\x048\x1f\xfd\x17\xf4\xe7\xeb\f\x96&K\x06\xef\x98\xf3K\xad<\x93\xae\xc3\xef\xff+\"\xedL\n\x92\xc2\xca?\xcabe\xe5\xb5\xdfR\xf03\x00\xa7@\xad\xcca\x01\x00\x00",
+		Mtime: 1792132191,
+		Size:  353,
+		Hash:  "dce31558b2e847f65c7140621411c1863d096224453355a1abb28df18d7393b3",
+	},
+	"templates/oneof_entry_simple.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8c\x91\xc1n\xe20\x10\x86\xcf\xe4)\xac\xd9\xcb\xee%\x1c\xf6\x9aDbaK\x91\"\x8c\x82_\xc0M\x06\xb0\xea\xd8il\xd4\"k\u07bd\xb2A\x11\xadz\xe0\xe8\xf1\xfc3\xdf\xfc\x7f!\x9a*\x9b\x15b\xc5\xfe\xad\x97\xbc\xe6M\t!\xb4Vۑ\x815h\x0f\xf9\x8bl_\x8f\xa3=\x9b\x0e\x88\xa0*\xe6buS,\xea\xcdz\x1b\xfb\x1dz\xaf\xcc\xd11\xf0\xf8\xe1s\xa9\xd5\xd1\xe4\x0e\xdf\xcehZL\xaa\x10r>v\xcaHM\xf4\xf0\x04#\xfb\xa4\x0eA\x1dX\xbe\xb4}\x8f\xc6\x13\xb1\xe7\xe6\xffS\t\xbf\x80\t\xcek\xb1\xd9\xc5\x01'\xdf\xeb\xbb\x1e\b\x01MGT]\xb5+\x1cFl\xa5ǎ\xa8\xd8G\x9a\xad\xec1\xa2\xc4\aj\x87DS\xf1&}\x18\xd3_\x86\x84\xf9\x83\x83\xf1+w\xaa\x1f\xf4\xb5c\xc7\x1bQ\xc2`\xa7]Pe\xb3Y\xa1\"\x91\xb8\f\x89Hťiw1\x8f\xe1\xa4\v\xa4\xe9\xa6\xeb\xd8o;xe\r\x03w\xb2\ufb3dV\x1dSF+\x83\xf0\x87(\x9bR\xbd\x8fk\xc9\xeb\xfdn\xb1-\xe1/<h|\x95ȾY\x1b\t\xbf\xf2%\xbb\xb2\xcf\x01\x00\xc2\xce3\xc7J\x02\x00\x00",
+		Mtime: 1792132491,
+		Size:  586,
+		Hash:  "bb6e53f6abc50f253d0b28043861b96abd9d7226d5b2beed975b2cec2f35caf1",
+	},
+	"templates/oneof_entry_suffix.tmpl": {
+		Data:  "<TR>\n\t<TD COLSPAN=\"4\" BGCOLOR=\"{{color \"oneof.background\"}}\"> \n\t</TD>\n</TR>",
+		Mtime: 1549992089,
+		Hash:  "4e6760d5500326fc3dcb24145b64202ca9793d66a6872d3a57b98dc8230f780d",
+	},
+	"templates/service_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x91\xc1j\x83@\x10\x86\xcf\xfa\x14˞ڋ\xb6\xf4\xba\n\x89\t! \x1a\xac=\xb5\x97U'q\xe9:ku\xd3\x06\x96}\xf7\xb2ڤ\xa1\x85\x1cg\xf8\x99\xf9\xe6\x1bcF\xd0Z\xe0a$\x14U\x03A?\xc0^\x9c\xa8\xb5\xc6\x04/(>\x8e`\xad\xf7:\xb6\xbc\x87\xa8\x97\\\xa0\x86\x93&Z)\xa9E\x1fQc\x82\x8cw\xe0\xe2bO\x82Du\x1d\xa0\xb6\xf6\r\x8diu'\xafZ\xc6\x006\xd6R\"y\x052b>+\x17\xcbtM\x96y\xb1Z\x17\x11}\xa4$Y\xa7\xe9\xb9|\x98\xcb\xe7\xdd\"\xd9f\x9b\xa9^n\x92<\xcd\v\xb7\xb5VR\r\x84\x8e0|\x8a\x1a\x82\x8a\xd7\xef\x87A\x1d\xb1\xa1\xd6\xd2\xd8\xf7XYľ\xe7\xb1rE\x92\xdc\r\xc9\"\xfaD\xc9./ʈ\xb6\xc0\x1b\x18n\xcd\xfbI8\xdaE\xba\xddd.\xf2+\xca)\b\xb8\x14\a\xbc\n\xbau\x1e\xab\xe2\x8b\x11\x16V\x13BX\xae\x1cP\xe8\x88&K\x1c\x9b\x8b\x16r\xa7z-\x14\x12:\xb6\xea\x8b\xd4sw$\x02\xa5@\xa0\xf7\xd6\xfa\xf3)\x7f/\xb9\x8d\x85\xbc\x83\t\x8a\x89\xf8\xdf#X(\xe2\x99\xea\f5=\xe6{\x00\xff\xfc[,\v\x02\x00\x00",
+		Mtime: 1792132191,
+		Size:  523,
+		Hash:  "9b4911c74463fe15e088aa4bc019d70ace563cadb3101b149b57ae7947f2d6df",
+	},
+	"templates/service_rpc.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x92A\x8f\x9b0\x10\x85\xcf\xf0+F\xd3K{(\xa8=V\x06)M\xda4\x12\n\x11\xf1\xbd\x820\x9bX\x02\x9b\xb5\x9d\xddD\x96\xff\xfb\n\xc8fY\xed!\xd9\xebx\xfc\xe6{3\x8f\xf1\"\r\x03\xc6\x170\xcbV\xcbu\x82\xce\x19\xb2VȽ\x01\xb4t\xb2Qو\xbd\x8cd\xd9\x12z\x8fΉ\a\x88\xe6\xaamIZ\xef\xe1_\xf1\xe7o\x82_\x10x\x9eg|\xb5\xe9\x05\x0e\xb6m&=\xe8\x1c\xc9\xda\xfb\x94U\xa9sѺl\xc9{\x16W)\x8b\xf9b\x1c\xde\u05f7VSٚ\x82\x1e\x8fd\xac\xf7o\xaf\xb0\xc9\v\x9e`\xa7\xae\xbf\xff\xeb\xb1\voP\xdbs7P\xf7\xfa\x17a~\xee\xe8U\x9cŽ{\xc6\v0\xf6\xdcP\x82\x95\xd25\xe9\uf572V\xb5\xbf\xe0Gw\x02\xa3\x1aQ\x83\xa6\x1a/\xa8\x13\xae\xdf\xcby\x9e\xe5E?~\xa7\x1a\xa5\x01\r\xe9'\xb1\xa3H\x93=j9\x8c\x0e\x83`ꮯ\x1b\xef\xc3\xe0\x86?\xd3)i\xe8^\x83\x9fA\xb90\x8c\x8b\b\x83\xe9*\x86떲\xbe^\x0f\xbe\xaa\xce\n%\x01\xcdA=\xc3n\xac\x1a\x10\xb2\x11\x92\xf0\x9b\xf7\xe15A\xd3\xcd\xcc\xf3l\xbb\x99\xad\x13\xfc\x89w\x06+e\"\xfd\x10\x1d\x16\x8b\xf4=ߐ\xa4\xf0e\x00ͣtV\xb6\x02\x00\x00",
+		Mtime: 1792132191,
+		Size:  694,
+		Hash:  "cb17ac2f5f6e758a355d78f5a0c7abad415733a66ce61e2e402ca9015f6242b4",
+	},
+	"templates/service_suffix.tmpl": {
+		Data:  "</TABLE>>];",
+		Mtime: 1549992089,
+		Hash:  "02177f2768fe5a0168d8d94e179b521d5164217e37a36a35f5215946b30d7583",
+	},
+	"templates/subgraph_begin.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8eѩ\xc30\fE\xbf\xe5)\x84\a\xc8\x02\x8fL\xf0\xa0\x14:@q\x125\tU\xab \xcb\x1f\xc5h\xf7b\x1c\xfaw\xe1\x9c\x03\x17r\x99VMǆ3\x97l\xa4\xf7Z\x87\xab\x8a\xc9%\xbd\xe8_\xf2F\xea\x8e5\x00p\x9a\x88q\xc4X\xebp\xdbD\xad\x19\xee1\x00\x98\b\xdb~\x9c\xf0\x97w\x98\xedÄ#>vfZ\xfe\x02@[\xb3\xb0h\x0f\xfa\x8c\xe7\x81aJ\xf3sU)\xef%\xba\xc7\xe6\x87\xef\x00\xaf;\x94\x98\xa6\x00\x00\x00",
+		Mtime: 1549992089,
+		Size:  166,
+		Hash:  "11f200753e97ce5b60aeaa806ee095136f575aaa74f782a4635ce7a2f9b1dbff",
+	},
+	"templates/subgraph_end.tmpl": {
+		Data:  "\t}\n\n",
+		Mtime: 1549992089,
+		Hash:  "279ba9a83a39d653361de3bebe401b96709e2aaed7ddf9c57e4469041e5552bb",
+	},
+	"templates/subgraph_entry.tmpl": {
+		Data:  "\t\t{{.}}\n",
+		Mtime: 1549992089,
+		Hash:  "46c50352f7e388502188734848b6c07d0d70f5bad68e37ca2a55ae895ff4a409",
+	},
+	"templates/to_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\xcd1\n\xc2@\x10\x85\xe1:\x9eb\x98>9@$\x96\x9e\xc0N,Č:\xb0\xd9YvG\x10\x86wwq\vK\xbb\xbfy\xdf\x1b\"\x9a\xb8k~4\xe2l\xabL\xa5\xca]\xdf\fDL\xc7j\x1b0\x17\xfb\xb6JZ\x81Y\x86\xf1@\x7fW'\x03\xe8|\xb3du\xe1\x88\x1e\xc4U\xd2\xd5\xd5r{j\x99$\xbf6\x06\x98\xdc,\xb9\x96\x85\x7fo4v\xbf+|\xd9\xef>\x03\x00\xd1\xc1M\x18\xa2\x00\x00\x00",
+		Mtime: 1549992089,
+		Size:  162,
+		Hash:  "b576b13bdf7a5e9967c0a425761724490f2ed0fe061337af95684a5a8f373d11",
+	},
+	"templates/to_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\xcd1\x0e\xc20\f\x85Ṝ\xc2\xf2\xde\x1e \xa8\x8c\x9c\x80\r1DĴ\x96\xd28J< Y\xbe;\"\x03#ۿ\xbc\xf7Mf\x9dT\xb9l\x1d\xb0H\xa2\xa56z\xf1\x1b\xdd͖k\x93\xc3=T\xf96SN\ue066\xf9\x02\x7fW7q\x0f;\xc5D\r\xeeO\xc9\xd2V4\x1b\x01\xd8(Ge)}\xe7\xba\x1c\xd4{\xdc\b\xdd\x11T$+\xd7\x15\x7f.\xccC\x1a\x7f\xf88\x9f>\x03\x00\xfbP\xb5\x05\xac\x00\x00\x00",
+		Mtime: 1549992089,
+		Size:  172,
+		Hash:  "05c05cba71ec84426a538e7b0cdeabdf32abb1b31f20b33b372967dc36a736b5",
+	},
+	"templates/to_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\xcd1\x0e\xc20\f\x85Ṝ\xc2\xf2\xde\x1e\xa0\xa8\x8c\x9c\x80\r1 j\x8a\xa54\x8e\x12\x0fHֻ;\"\x03#ۿ\xbc\xf7\r\x11M\xdc5o\x8d8\xdb*S\xa9\xf2\xd47\x03\x11ӹ\xda\x0e\xccž\xad\x92V`\x96a<\xd1\xdf\xd5\xc5\x00\xba>,Y]8\xa2\aq\x95tw\xb5\xdc^Z\xa6][Ӽ1\xc0\xe4fɵ,\xfc\x03i\xecD?\xe2\xdb\xf1\xf0\x19\x00%\x87\xd0\x17\xa5\x00\x00\x00",
+		Mtime: 1549992089,
+		Size:  165,
+		Hash:  "82ebe5760c48452c72af8e7e15f292a2fc71e99b3dd7aba5af4f292370348024",
+	},
+	"templates/plantuml/begin.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94S͊\x13A\x10>o?E1\x97\xe8\xc1\xd9{X\x16Q\x10\x0f\"\xe2>A\xa5\xbb2)\xd2?cW\x8d\xecn\xd3\xef.3\x13IfMB<\xd6\xcf\xf7\xd3\x1f\xd5+p\tbR Ǻ6+\xc0AӇ\x8e\"eTr\xb0y\x81\x8eu7lZ\x9b½\x10\x06\xc6\xfb>'M.\xa9\xf9(\x8aY\x87\xe0\x8dYA\x8fv\x8f\x1d\xad\x01\xa0\x94\xf6\xc7\\\xd5jV i\xc8v\x1aL\x93\x11\x1d1\x1cf\xe4\xc9*\xa7\xb8\x1egO\x7f\xabZ\x8d)\x85\xb7@\xbf\xe0\x9d\x90*\xc7N\xa0I\x99)*\x8e\x1b\xcd{h\xbe\xfdlj\xf5\xb4U\xd0\x04\x99\xbb\x9d\x82\xe3<3\x94B\xd1\xd5j\x94\xd5\xd3\xd2ю\x1d\x01\x85^_ P\xd8P\x96\xb9e9[O\xc6Ȟc\x8f\x19\x03l\xd0\ueedc\x86\xe8>'\x9f2h\xc6(=f\x8az\xb2\xe5h\x8b\x83\xd7/)\xeaw\f\xa3\xd8\xd1qL\x8e\xdam\x8aڎOnj=\x8f{\xe2\xd7K8\xe1\xd778\xebQ\x04\x8a\xb9\xfb\xb4\xb4\xf7\xf0\x10H\x04;z|\x84R\xec\xe4\xb89\xb4\xda\xe3SF\xb2\xbb\xaf\x84\x8e\xf2\xff\x10\xec&\xc4\x04\xfe\aFq\b\xa7\x98\xb1\xbeQ\xf1,\xf4\x9a\x96P\xfe\xcdva\xf1кQ\xf1\n\xc15\xdd\xc0\"\x1c\xbbE4s\xeb\xd6l/\x13\\\xcd\xf6Y)\xbaEDS\xe7\xd6|/\xc1\x8f\x9a\xa7\xb7u\xf8\xc5g\xae\xebH`\xfd J\xf9\xad\x81\xf1\x8e/l*=\xeb\xacd\xfe\f\x00Ķ\x9fsr\x04\x00\x00",
+		Mtime: 1792132310,
+		Size:  1138,
+		Hash:  "4501dce71d074244828b5dc9b05950293800e1606679dc5cb6ea549160f67761",
+	},
+	"templates/plantuml/comment.tmpl": {
+		Data:  "\n' ------ {{.}} ------\n\n",
+		Mtime: 1792132205,
+		Hash:  "0717db0520e3a0cbf8315a1b4ce14efe2151d3b5a84da2429a5a1c29362af450",
+	},
+	"templates/plantuml/end.tmpl": {
+		Data:  "\n' {{.AppVersion}} on {{.Timestamp}}\n@enduml\n",
+		Mtime: 1792132205,
+		Hash:  "c441ad73d42a89d5e37a8182d0b96133f07cc13da3a9a6613cc8297e12694eb2",
+	},
+	"templates/plantuml/entry.tmpl": {
+		Data:  "{{.}}\n",
+		Mtime: 1792132205,
+		Hash:  "6c6fd082a040f46553ec4d799246d7c309ca0392f9331029891dd1a9c74d5ea0",
+	},
+	"templates/plantuml/entry_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f1N\x041\fEk\xf6\x14\xd6T\xd0d\xfbUH\x035Pp\x810\xf1\b\x8bĉ\x92\x8c`e\xf9\xee(C\xd8\xce\xfez_Ͼ\x93\x8d0\x06\x05\x11\xda\xc0<c\xa9\xb8\xfa\x8eA\xd56'b^|BU{\x1e\vƆ\xaa\xb7P\x049\xa8\xc2e\x96\xdf*n\xf4s\x00\xff#L\xc6~\xf8\xf5\xeb\"\xb2\xe6\x98+,\xfdZ\xd0 \xefiQuv\x1f\x9e\xf7k9<\xbb\xb3\xe7\x01;x\x04\x11\xf3Z\x03\xb1\x8f\xaa\xa7\xc3\xe19\x80y\xca)!w\xb8ϥSfX\xdag\xfe\x86\xf5/m@\x1c\x89qyP\xbd=gi(foXȝ\xe6e\xbf\x03\x00I\xae?\x1b\x01\x01\x00\x00",
+		Mtime: 1792132491,
+		Size:  257,
+		Hash:  "1263ddb2c53f9afdc378c58135cbaf15b1c877d2eb32a4cf6828e2915654f6c0",
+	},
+	"templates/plantuml/entry_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f=N\x041\f\x85k\xf6\x14V*h\xb2\xfd*\xa4\x81\x1a(\xb8@&\xf1\x80E\xfe\x94D\x82\x95廣\fa;\xfb\xe9{\xfa\xec;\xde\tc\x10`\xa6\x1d\xf43ֆ\xde\r\f\"\xa6[f\xfd\xe2\x12\x8a\x98\xf3\\0v\x14\xb9\x85̘\x83\b\\V\xf9\xad\xe1N?\a\xf0?\xc2b\xcc\xe6\xfcׅٗX\x1a\xa8q\xad\xa8\x13\xf6\xee>P\x89X\xb3M\xd5\xfb\xb5\x1e\xaa͚\xf3\xe4-<\x02\xb3~m\x81\xb2\x8b\"\xa7C\xe3r\x00\xfdTR\xc2<\xe0\xbe\xd4A%\x83\xea\x9f\xe5\x1b\xfc_ځr\xa4\x8c\xeaA\xe4\xf6\x9f\xa1\xa9X\xbdi!{Z\xc7\xfd\x0e\x00\x9e\x95B\x00\x04\x01\x00\x00",
+		Mtime: 1792132491,
+		Size:  260,
+		Hash:  "7a96a82d8bf94712ab2c6787a37aac089b770d831aa1369600abd0975690445c",
+	},
+	"templates/plantuml/entry_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f1N\x041\fEk\xf6\x14\xd6T\xd0d\xfbUH\x035Pp\x81\xcc\xc4\x03\x16\x89\x13%\x91`e\xf9\xee(C\xd8\xce\xfez_Ͼ\x93\x9d0\x06\x05\x11\xda\xc1<c\xa9\xb8\xf9\x8eA\xd56'b^|BU{\x1e\vƆ\xaa\xb7P\x049\xa8\xc2e\x96\xdf*\xee\xf4s\x00\xff#LƮ~\xfb\xba\x88l9\xe6\nK\xbf\x164\x89Z#\xfeXT\x9d]\x87\xea\xfdZ\x0e\xd5\xea\xecy\xf0\x0e\x1eAļ\xd6@\xec\xa3\xea\xe9\xd0x\x0e`\x9erJ\xc8\x1d\xees\xe9\x94\x19\x96\xf6\x99\xbfa\xfbK\x1b\x10Gb\\\x1eTo\xffY\x1a\x8a\xd9\x1b\x16r\xa7y\xdc\xef\x00\x1b<\b\xd7\x04\x01\x00\x00",
+		Mtime: 1792132491,
+		Size:  260,
+		Hash:  "67ec7c9c0e6c9df9c2b060ed21405b381a05b31704df4ac5f3c147d3fd6ca699",
+	},
+	"templates/plantuml/entry_simple.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f\xbdN\xc70\f\xc4g\xfa\x14V&X\xc2^\x85.0\x03\x03/\x10\x12WX\xe4KI$\xa8,\xbf;Jɿ\x9b}\xba\xbb\x9f}\xc7;a\xf0\x02̴\x83~\xc1R\xd1َ^Ĵ\x8dY\xbfڈ\"\xe6q,\x18\x1a\x8a\\\"3&/\x02\xeb\f\xbfW\xdc\xe9\xf74\xdcF\x98\x1e\xf3i\xdd\xf7\xca\xecr\xc8\x15T?\n\xeaF\xb1\x04T\"\x03\xf3q\x94\x133|\x1b<\x01\xb3~\xab\x9e\x92\r\"\xcbYo\x93\a\xfd\x9cc\xc4\xd4\xe1>\x97N9\x81j_\xf9\aܿڀR\xa0\x84\xeaA\xe4\xfa\xcbШ\x9f\xb9A\xa0m\x99G\xfd\r\x00A\xd7َ\xfc\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  252,
+		Hash:  "e092c6f528e4dae662df1f8c5b283c514a9043706fa65e0b168595b97cea152c",
+	},
+	"templates/plantuml/enum_entry.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\xcd1\x0e\xc20\f\x85\xe1\xb9=\x85\xd5\t\x96p\x81\xd0\x05fF\xf6\xa8q\x85\xa5ĩH\x10\x83\xf5\xee\x8e\x02\x15\xa3\x7f\xc9\xef\x1b\xccd%w\xe5\xed\xc9Kh\x1c\x01_g3w\v\x99\x01\x7f\xea\a\xa7\xca\xc0?\x9a\xb1F\x80\xced\xe6\xee!\xbd\x18\x18\xbf;A#\xb9Kə\xb5ѡlM\x8a\xd2T\x1f\xe5M˯V\x12M\xa2<\x1d\x81\xc1V\xe1\x14A^\xba\xb8\xffuT\xe6qG>\x03\x00\xc0қ\x1e\xa0\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  160,
+		Hash:  "93f166216fea9bfef804377b19a54e69a67acacff6e6a3d0b7fff88030585653",
+	},
+	"templates/plantuml/enum_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4\xcbA\x8a\x021\x10\x05\xd0\xf5\xf4)\x8a\xacf6\x99\v\x84lܻ\xf3\x00\x8d\xa9ւN\xa55i\x14>\xff\xee\"\xe2\xf6\xc1S߫\x04 \x1e\xe7\xaad\x90\xb9\v\xd0u\f\xf3K\x97\xe0\xadh\xdc\xee\xba\xd83\x90@<\xb9\xddv%%\xa5\xf7\xcdY0\x01\xb6\xc8\xecE\xe2\xa1ժ>\xe4\xb7mÚK\xe8\xd7\xf6\x90\xf3G\xbb\x98\xaf\xe6\x1a\xfe\xc8\x1f,\xa6k\xa1$\xcb\xc0\xf7\x91\xe9\xdf\xf2\x04\xa8\x17\xf25\x00\xa6\x14\x15Ɯ\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  156,
+		Hash:  "479695f1a6ed8af0fc5d82c74168ed371435ce1aeea2c1123b537f56b62128fd",
+	},
+	"templates/plantuml/enum_suffix.tmpl": {
+		Data:  "}\n",
+		Mtime: 1792132205,
+		Hash:  "412ca345ccf75bf9c0806bce695be8de808b79984251a7a54d202cf6101dd451",
+	},
+	"templates/plantuml/import_link.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x8f\xb1\n\xc20\x10\x86w\x9f\xe2\xc8\xdc\xe6\x01\x1c\\\x04\x97\xaen\xe2\xd0&W<L{5UD\x8e{w1M+Bq\xca\x0f_\xbe\x90O\xc4\x1e\"w\xaa B-\xd8\xfd\xcb\x05T-O\"\x8e\x03G0\xd4\r\x1c\xef\xd6}\x80Q-\x1a\x0e\xfe\\\xeeD0\x8c\b\xd4\x02\xde\xc0V\xd4{0ã\t\xe4̚\xbe\xa0\x7f\xfe\x13\xeb몝A\xe1\xeb\xf1\x82_[\xb5L\xb3\xf7\xe9\xff\xf6Ȫ)\x83c~r\xee\x81\xed\x94W\xd1|wZY\xfei\x87\x94\xba\x90tl\xde\x03\x00Ȍ\xd6!(\x01\x00\x00",
+		Mtime: 1792132851,
+		Size:  296,
+		Hash:  "43c6e20c5a4f4d096985ee78ab8256460acc8f288605d7a26f2c7495a44ff74c",
+	},
+	"templates/plantuml/package_node.tmpl": {
+		Data:  "folder \"{{.PackageName}}\" as {{.NodeName}} #cornsilk\n",
+		Mtime: 1792132904,
+		Hash:  "519f9c0d325afecea49348fb9253f1cc9b65d01ed489d777a4344d0f7820a8fc",
+	},
+	"templates/plantuml/package_link.tmpl": {
+		Data:  "{{.From}} --> {{.To}} : {{.Count}}\n",
+		Mtime: 1792132904,
+		Hash:  "e5a90f9cf83971041009604c911171bd741580e517736678a583683aa68b7900",
+	},
+	"templates/plantuml/import_node.tmpl": {
+		Data:  "file \"{{.PackageName}}\\n{{.FileName}}\" as {{.NodeName}} #cornsilk\n",
+		Mtime: 1792132205,
+		Hash:  "9572d0a845ba575e51ebeb4c383f88f40bed05649977d7a256e52627aca27cc8",
+	},
+	"templates/plantuml/import_node_missing.tmpl": {
+		Data:  "file \"{{.FileName}}\\n(missing)\" as {{.NodeName}} #lightpink\n",
+		Mtime: 1792132205,
+		Hash:  "2600d9d71b2758cb1cb6c7cd4b275740c4699cd36e2fa9a779551fa316f78a60",
+	},
+	"templates/plantuml/map_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f\xcdJ\x051\f\x85\xd7ާ\b\xb3R\x90\xce\xfeR\xbbѝ\xa0\x1b_\xa0Ns1ئe~\x90!\x1c\x9f]\xea\xbd\xcc2\x87\x93|_\xee\xec\"\x9c\x13\xc8L.\xe4^\xb8\xcd<ŕ\x13\xe0\x97`\xe6\xdeba\xc0\x8f}\xe0\xbc0p\x84f\xac\t\xa03\x95\xd8~\xbd\x99{\xe5\xfdco\f<\x92\xff\x8c\xd3\xf7\xd9l\xaa\xb9\xce4\xac{cǺ\x95\x01\b~뗯M?n\xc1\x8f\xbd\x1c\x02=\x91\x99{\x9f\x93h\xcc\xc0\xe9\xdf)j\"\xf7\\Ka]龶U\xaaҰ|\xd5\x1f\x9a\xae\xe9B\xa2Y\x94\x87\a\xe0\xf8\xc7Kg\xdc\xf6:F\xc2\xe9&\xfc7\x00\xd8\x192\x00\xf4\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  244,
+		Hash:  "5c925dae42981bdf0b99b2abb49a438dc7406bab00e30cf7410d4ccf7762cdb2",
+	},
+	"templates/plantuml/map_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f\xcdJ\x051\f\x85\xd7ާ\b\xb3R\x90\xce\xfeR\xbbѝ\xa0\x1b_\xa03\xcd\xd5`\xff\x98\x16d\b\xc7g\x97z/\xb3\xcc\xe1$ߗ;\xbd\b\xc7\x00R\x95\v\x99\x17\xae\x1b\xaf\xbes\x00ls\xaa\xe6\xcd'\x06\xec<\x06\x8e\x8d\x81#T\xe5\x1c\x00:S\xf2\xf5ת\x9aW\xde?\xf6\xca\xc0#\xd9ů\xdfgյĲ\xd1\xd4\xf7\xca&qk\xfe\x93'\xc0\xd9e\x1c\xbf\x96\xed\xbc8;\x8f\xbes\xf4D\xaa\xe6}\v\x92}\x04N\xffZ>\a2\xcf%%Ν\xeeK\xedR2M\xed\xab\xfc\xd0zM\x1bI\x8e\x92yz\x00\x8e\x97\xac\f\xc6mo`ĝn\xce\x7f\x03\x00\x8diq\xe2\xf7\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  247,
+		Hash:  "64c351990ccba41d8c7c6da72cc27ea251dc2a083a5d41104202f11b7e30b147",
+	},
+	"templates/plantuml/map_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f\xcdJ\x051\f\x85\xd7ާ\b\xb3R\x90\xce\xfeR\xbbѝ\xa0\x1b_\xa03\xcd\xd5`\xff\x98\x16d\b\xc7g\x97z/\xb3\xcc\xe1$ߗ;\xbd\b\xc7\x00R\x95\v\x99\x17\xae\x1b\xaf\xbes\x00ls\xaa\xe6\xcd'\x06\xec<\x06\x8e\x8d\x81#T\xe5\x1c\x00:S\xf2\xf5ת\x9aW\xde?\xf6\xca\xc0#\xd9ů\xdfgյĲ\xd1\xd4\xf7\xca&Ik\x92?'\xc0\xd9e\x1c\xbf\x96\xed\xbc8;\x8f\xbes\xf4D\xaa\xe6}\v\x92}\x04N\xffZ>\a2\xcf%%Ν\xeeK\xedR2M\xed\xab\xfc\xd0zM\x1bI\x8e\x92yz\x00\x8e\x97\xac\f\xc6mo`ĝn\xce\x7f\x03\x00\x8bTt\x7f\xf7\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  247,
+		Hash:  "1a269d2566214882c7c9555d79fc33451050a6ef95046dfcfd3473cea93feefa",
+	},
+	"templates/plantuml/map_simple.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8f\xcdJD1\f\x85\xd7\xceS\x84\xae\x14\xa4\xb3\x1fj7\xba\x13t\xe3\v\xd46\x83\xc1\xfeq[\x90K8>\xbb\xd4\x19f\x99\xc3ɗ/wz\x16\xce\t\xa4*g\xb2/\xdc7\x8ear\x02\xdc\xf0\xaa\xf6-\x14\x06\xdcq\r\x9c\a\x03\xb7P\x95k\x02\xe8D%\xf4_\xa7j_y\xff\xd8;\x03\x8f\xe4>C\xfc>\xa9Ɩ\xdbFf\xee\x9d\xed\x90\xd23\x1b`\x81/Ew\\=\xef\xe9\x89T\xed\xfb\x96\xa4\x86\f\x1c\xfeuBMd\x9f[)\\'ݷ>\xa5U2\xe3\xab\xfdP\xbc\xa4\x83\xa4f\xa9l\x1e\x80\xdb+N\x16\xff\xba\xb7N\x88?\\]\xff\x06\x00R\a\u07b4\xef\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  239,
+		Hash:  "4db04893808996b27877be03d1084cf4a24c3b6246aef456176c98ffaa47164d",
+	},
+	"templates/plantuml/message_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4\xcb1\n\x021\x10\x05\xd0\xda=ŐJ\x9bx\x81\x90\xc6\xde\xce\x03\x84\xcd\xec:\xb0\x99\xacND\xe13w\xb7\x10\xdb\aoފ\x19\x05 ^Kc\xf7@\xc5\b0\x1eCt5\n\xda+\xc7\xfdɋ|\x82;\x10o*\x8f\x17\xbbSJ\x8d\xcd\xca\xca9\x13&@\x16*Z)^zk\xac\x83\x8e}\x1fҕ\x82\xdd\xfb\x9b\xe6\x9f\x1a\x89n\xa2\x1cN\xee\a,\xc2[uJ\x92\x81\xffsOg\xc9\x13\xc0Zݿ\x03\x00\x9f\U000c7360\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  160,
+		Hash:  "b30b90cfe1a75fd17875222f7897363b5e79da2ec52458ae90d798b533d3dba2",
+	},
+	"templates/plantuml/message_suffix.tmpl": {
+		Data:  "}\n",
+		Mtime: 1792132205,
+		Hash:  "412ca345ccf75bf9c0806bce695be8de808b79984251a7a54d202cf6101dd451",
+	},
+	"templates/plantuml/missing_node.tmpl": {
+		Data:  "class \"{{.Name}}\" as {{settings \"node.prefix\"}}{{.Unique}} <<missing>> {\n\tthis type is missing\n}\n",
+		Mtime: 1792132205,
+		Hash:  "d133da5e28987caf6e0a9cab2ced87cfada47386066179e5279ad7710f21afa3",
+	},
+	"templates/plantuml/unused_node.tmpl": {
+		Data:  "note top of {{settings \"node.prefix\"}}{{.Unique}} {{color \"unused.highlight\"}} : unused\n",
+		Mtime: 1792132801,
+		Hash:  "8d17692675095df9a59f56514116d4983e64e53cf5daf2b1c58be3b7ffea3727",
+	},
+	"templates/plantuml/collapsed_node.tmpl": {
+		Data:  "class \"{{.Name}}\" as {{settings \"node.prefix\"}}{{.Unique}} <<message>> {{color \"collapsed.background\"}} {\n\t<i>+{{.Hidden}} hidden</i>\n}\n",
+		Mtime: 1792132992,
+		Hash:  "c8e7ce3830994cfb2262ae2a938dc5507cbbbc95102489b0d080f7cdbcd3d6b4",
+	},
+	"templates/plantuml/oneof_entry_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8e1N\x041\fEk\xf6\x14V*h\xb2\xfd*\xa4\x81\x1a\x1a.\x10\x12\xaf\xb0H\x9ch\x92\x11\x1aY\xbe;\xca\xechJ\x7f\xf9\xff\xf7\x9e\xe4N\x98\x93\x82\b\xdd\xc1\xbec[0\x86\x81I\xd5u/b?BAUw\x9d\a措g(\x82\x9cT\xe1\x06\xee;\xc4ߛH\xac\xb9.`\xc6\xd6\xd0\"\xafŨz\xb7Ι\xaf\xad\xed3\xabw\xd7\xf9\xec\xe1\x15D\xec璈CV\xbd\xec\xfc\xc0\t\xec[-\x05y\xc0sm\x83*\x83\xe9?\xf5\x0f\xe2#\xed@\x9c\x89Ѽ\xa8\x9e\xee\x8e&\xe2\xe8M\n\xf9\xcb!\xf7?\x00\b,cr\xe0\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  224,
+		Hash:  "96ac811266555a8c26ddda93e7c7cb37c3bba843dae4e8bdacb15d5b387d9a18",
+	},
+	"templates/plantuml/oneof_entry_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8e\xbdN\xc60\fEg\xfa\x14V&Xҽ\nY`\x86\x85\x17H\x13\x17,\xf2\xa7&\x12\xaa,\xbf;J\xbf\xaa\xa3\xaf|\xef9O\xbc\x11\xc6 \xc0L\x1b\xe8w\xac;z\xd71\x88\x98f\x99\xf5\x87K(b\xe6q`l(r\x87̘\x83\b,`V\xe7\x7f\x17f_b\xd9A\xf5\xa3\xa2Nؚ\xfbF%b\xcd:\x96\xbe\x8ez.\xad\xd6\xcc\xe3\xdf\xc2+0\xeb\xcf=PvQd:\x15\\\x0e\xa0\xdfJJ\x98;<\x97کdP\xed\xa7\xfc\x81\x7f\xa4\r(Gʨ^Dn}C\x03q\xf5\x06\x85\xect\xf9\xfd\x0f\x00\x16\x95r\x1a\xe3\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  227,
+		Hash:  "2db95699fc37f8250c60deb19e2ec68ecfaabfc48afb1a4875043ad31a610811",
+	},
+	"templates/plantuml/oneof_entry_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8e1N\x041\fEk\xf6\x14V*h\xb2\xfd*\xa4\x81\x1a\x1a.\x90I\xbc`\x918\xd1$\x12\x1aY\xbe;\xca\xechJ\x7f\xf9\xff\xf7\x9e\xe4N\x98\x93\x82\b\xdd\xc1\xbec[1\x86\x81I\xd5u/b?BAUw\x9d\a措g(\x82\x9cT\xe1\x06n\t\xf1\xf7&\x12k\xae+\x98\xb15\xb4\x85z'\xfe6\xaa\xde-s\xe9kk\xfb\xd2\xe2\xddu\xfe{x\x05\x11\xfb\xb9&\xe2\x90U/\xbbB\xe0\x04\xf6\xad\x96\x82<\u0e76A\x95\xc1\xf4\x9f\xfa\a\xf1\x91v \xce\xc4h^TO}G\x13q\xf4&\x85\xfc\xe5\xf0\xfb\x1f\x00\x93<8\xcd\xe3\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  227,
+		Hash:  "f9af465b728899872f015c67ca57cad05b1037c8ec917633abdf70e756cf6f7b",
+	},
+	"templates/plantuml/oneof_entry_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4̱\xae\xc20\f\x85\xe1\xb9}\n+ӽ\x8b\xd9Q\x95\x85\x9dw\b\x89\x03\x16\x89\x8d\xda\"\x06\xcb\xef\x8e\x02b\xfd\x8e\xce?!\xc2rI\xf9~4\xcb\xdat\x85\xa0BZq\xd8uէ\x94\xe0\x1e?\x06fxN\x9dܗØ# \xcef\\!I\x01<i\xef$;\xfc\xe9cg\x15\b\xdbM_\x90\xbf\xba\x01Kc\xa1\xf0\xef>Yej\xc5a\xe1h\xf6\xfb\x8d*\xc7ٌ\xa4\xb8\xbf\a\x00\xe2\\\x9aW\x98\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  152,
+		Hash:  "ec42691137c42c524cb021d9d29f8538c6ff1a50b2e14fb1384949ad4e7ce994",
+	},
+	"templates/plantuml/oneof_entry_simple.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8e\xbd\xaa\xc30\f\x85盧\x10\x9e\xee]|\xf7\xe0fi\xe7v\xe9\v\xb8\xb6BE\xfdGl(A\xe8\u074bӐQ\a\x9d\xef|?<\x13\x06/\xc0L3\xe8\v\x96\x05\x9dm\xe8EL\x9d\x98\xf5\xd5F\x141\xff\xfd\xc0PQ\xe4\b\x991y\x11\x18\xc1<\xac{\x8d\xcc.\x87\xbc\x80jkA])\x96\x80J\xa4S\xeek\xd9(\xfdo\x82\x130\xeb\xdb\xe2)\xd9 2l\xd36y\xd0\xe7\x1c#\xa6\x06\xbf\xb94\xca\tT}\xe67\xb8oZ\x81R\xa0\x84\xeaO\xe4\xd06\xd4\xf1{\xaf/\xd04\xec^\x9f\x01\x00\xc7\xf1Ŀ\xdb\x00\x00\x00",
+		Mtime: 1792132491,
+		Size:  219,
+		Hash:  "e40f99d2227022e5e48d75015fa8c68e35081e99563362d1cf710adf03c94870",
+	},
+	"templates/plantuml/oneof_entry_suffix.tmpl": {
+		Data:  "\t..\n",
+		Mtime: 1792132205,
+		Hash:  "0a27785056c130696f28714ffd375eb12a0fa478cb1aa2255520e4bdea31bbfb",
+	},
+	"templates/plantuml/service_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4\xcb?\xae\xc20\f\a\xe0\xf9\xf5\x14V\xa6\xc7\x12.\x10eag\xe3\x00Qゥ\xc6)u\xf8#\xfd\xe4\xbb3 \xd6O\xfa浘Q\x00\xe2\xb94v\x0fT\x8c\x00\xe31D\xafFA{\xe5\xb8\xed\xbc\xc8;\xb8\x03\xf1\xa2r\x7f\xb0;\xa5d\xbc?e\xe6\x9c\t\x13 \v\x15\xad\x14O\xbd5\xd6A\xff}\x1bҕ\x82\xdd\xfa\x8b\xe6\xaf\x1a\x89\xae\xa2\x1c\x0e\xee\x7fX\x84\xd7\xea\x94$\x03\xbf瞎\x92'\x80\xb5\xba\x7f\x06\x00?M.\xe1\xa0\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  160,
+		Hash:  "37b499156b1b6c15eefda32019b764b810f71699b7b4051b5c7a02ceebb4c99d",
+	},
+	"templates/plantuml/service_rpc.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffdϱn\xc3 \x10\xc6\xf19y\x8a\x13S\xb2\x90\xddB,\xdd;\xb4}\x01\x8c\xcf\xf2\xa9\x06\\\xc0\xad\xaaӽ{\x85\xed\xc5\xcd\xfaG\xdcO߅\x03\xd6)\r\x02\xa6\xb7\xcc\xfa\xd5\x05\x141\x8f\xdeޘi\x04\xfd^3\xbaP\xde\xf0k\xc5RE\x98\x9f\x120c\x1c\xb6\xa7\xa3}\xfc.(r\x87\x0eL\xef\xfcg\xc7\xecӜ2\xa8\x82\xf9\x9b<\xea\x8cu\xcdQ\x89\xd8\x7fJ\xcb\xe5\xac\x1c\xe9\xa4lmẈ\x11\xf6\xba\x1drq\x00\xfd\x92B\xc0XᖖJ)\x82*S\xfa\x01\xbf\xd7\x02\x14g\x8a\xa8\xee\"\x17\x1e\t綝\xda\xf6\xe3_;I\xf6\xbas\xf27\x00\x8bۗA!\x01\x00\x00",
+		Mtime: 1792132205,
+		Size:  289,
+		Hash:  "7d97e2939d88a279499dd91b625295791650f0c1eff0504a8ad27d1c9df3b085",
+	},
+	"templates/plantuml/service_suffix.tmpl": {
+		Data:  "}\n",
+		Mtime: 1792132205,
+		Hash:  "412ca345ccf75bf9c0806bce695be8de808b79984251a7a54d202cf6101dd451",
+	},
+	"templates/plantuml/subgraph_begin.tmpl": {
+		Data:  "package \"{{.ShortName}}\" {\n",
+		Mtime: 1792132205,
+		Hash:  "ca039e9e876d8f75f01b83df738270d3f29323b5fd4632afab46c99ff8062a5e",
+	},
+	"templates/plantuml/subgraph_end.tmpl": {
+		Data:  "}\n\n",
+		Mtime: 1792132205,
+		Hash:  "938de74be97bf3aba7872a61ba0e42084008c81e8a202a612134c755aaf7fa06",
+	},
+	"templates/plantuml/subgraph_entry.tmpl": {
+		Data:  "{{.}}\n",
+		Mtime: 1792132205,
+		Hash:  "6c6fd082a040f46553ec4d799246d7c309ca0392f9331029891dd1a9c74d5ea0",
+	},
+	"templates/plantuml/to_enum.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|̱\tBA\f\x06\xe0\xde)B\x06\xc8\x00\x16\x96N`'\x16\xe2\x8b\x1a\xb8K\x8e\xdc\tB\xf8w\x17\x17x\v|US\xd72\x7fMb\x8fMe\xa4>\xed\xcb@\x95\x9c3:@r\xadzD\x8b$Nm\xf7e\xe1\xf3mC\xd4?\x9d\x81\x9b\x9ch\x97\xb9\x04@G\xfa\x83\xa6m\x03\x0e\xbf\x01\x00\xe6g\xcc\x03v\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  118,
+		Hash:  "f57753c38875002f25337e528a0918f66bb120999bb1bff82a88cf65401d66ae",
+	},
+	"templates/plantuml/to_message.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|̱\r\x021\f\x05О),\xf7\x97\x01((\x99\x80\x0eQ\x9c8\x13,%qd\xa7@\xb2\xfe\xee\x88\x05X\xe0e\x86\xac\xa5\xa3\x06\xf1\xb0C\xcaty釁\xccru\xeb\x00m\xf7̧5sb\x97\xb6/\xb5\x11o\x9d\xa5K\xc4^\x85\x81\xc7v\xa1\xbf\xd2\xcd\x00:\xd3\xcfTi\ap\xfa\x0e\x00\x16\x00\x87\xc2y\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  121,
+		Hash:  "8c69c7fd9450e804b06a6cf07517ba0f1e877d6e4493c88fd30c05d1a89f65b7",
+	},
+	"templates/plantuml/to_missing.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|̱\r\x02Q\b\x06\xe0\xde)\b\x030\x80\x85\xa5\x13\xd8\x19\v\xe3\xe1I\xf2\x0e.\xf0\n\x13\xf2\xefn\\\xe0\x16\xf8\xbaK\xe74_\x8b\xd8cQ\xd9S\xdf\xf6e\xa0[\xae\x19\x1b@r\xef~ň$N\x1d\xcfi\xe1\xf5\xb1]6\xab2_\x19xȅ\x0e\xa5[\x00t\xa6\xbfi:\x16\xe0\xf4\x1b\x00+l΄y\x00\x00\x00",
+		Mtime: 1792132205,
+		Size:  121,
+		Hash:  "46a548a7fe14278cb330ba940f07bc24d79d5fa7b0cebd3f880c696bad79b5ea",
+	},
+	"templates/extend_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x91\xc1j\xe30\x10\x86\xcf\xf6S\b\x9dv/\xf6.\xf4(\x19\x12Ǆ\x80\xb1\x83\xe3\x1eJ{\x91\xa3I,*K\xae\xadЀл\x17\xd9I\x1aZ\xc8q\xc4\xe8\x9fo\xbe\xb1v\x04c\x84:\x8e\b+\xcd!\xea\a8\x883v\xce\xda\xe8Y\x89\x8f\x138\x17\xbc\x8e-\xeb\x81\xf6\x92\te\xe0l\x90\xd1Z\x1a\xd1S\fg\x03\x8a#k\xa3\x82u\xe0\x7f\x89\x03\x8aR\xddu\xa0\x8cso\xca\xda\xd6t\xf2\xee\xc9ZP\xdc9\x8c$k@R\x12\x92z\xb1\xcc3\xb4,\xabUVQ\xfc\x1f\xa34\xcb\xf3k\xf9o.w\xdbE\xba)\xd6S\xbd\\\xa7e^V\x14[\xbb\xd7R\x0f\xe8B\x115l\xff~\x1c\xf4Iq\xec\a\xec\xea\x97<\xa3\x98\xb3\xb1\x05\x8e\x930 u\x95\x84A@\xea\x15JK\x1fYP\xfc\x84Ѷ\xacj\x8a[`\x1c\x86\a\xe9\x97\x06\x9f\xbc\xc87\xeb\xc2w|\xcb\xf3Z\"&\xc5Q\xdd5\xfai\xc1E\x11i\x92\x9b%\x127\x13H\\\xaf<V\xec\xb9&sL\xf1\x9b*\xf4G\xf7Fh\x85\xf0\xd8\xeaO\xb4\x9f_G$\x94\x14\n\xf0_\xe7\xc2y\xa1\x9f\xfb<\xa6S\xac\x83\x89\x8d\x88\xe4\xd7qH,\x92\x99\xea\n5\x1d+\xfc\x1a\x00\x16\xb0\xa1\xce'\x02\x00\x00",
+		Mtime: 1792132309,
+		Size:  551,
+		Hash:  "1dc55403e22714905ddba3a4c32c989061058a235ef0faa65716fb32c1307c58",
+	},
+	"templates/message_extensions.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffL\xcf\xc1J\xc3@\x10\x80\xe1s\xf2\x14\xc3x\xdf^<&\x81\x9aj-\x84l\x89\xfb\x02k;\xc6\xc5\xec\xactW\xa8\f\xf3\xee\xb27\xef\x1f?\xfc\x9d[\x86\xb6\xe9\xdc\x01F;\xbd\x9d\xf7s\x8f\x8f\bO\xc7\xd1Nv\xe9Q䒶t\x03\xa4{!\xce!q6\xef\xfe\xf2\xb5\xde\xd2\x0f_Q\x15a?\x9d\x8es\x95\x99J\t\xbcf\xc0B\xf7b\xfc\x16V6\xec#U&\x12>\xc0\x8c)F\xe2\xa2\n\xaf\xcb\xf3K\x8f\x0f\b\xce\xdaɝ\xce5\xf0Y\xe2\xf6Ϡ\b\xf1Uuh\x9bF\xc4\xcc>\x92*\x88\x18\xf7\xfbM\xaam\xd3\xed\xdcah\xbb]]\xf8\x1b\x00\xe0\x0fg\f\xc8\x00\x00\x00",
+		Mtime: 1792132309,
+		Size:  200,
+		Hash:  "e4e2db72245133daff46593d3cb5c66a395573a5cfd5f73b826e3cbd7d8ba446",
+	},
+	"templates/message_reserved.tmpl": {
+		Data:  "<TR>\n\t<TD COLSPAN=\"4\" BGCOLOR=\"{{color \"reserved.background\"}}\" ALIGN=\"{{settings \"text.align.name\"}}\">\n\t\t<i>{{.Name}} {{html .Type}}</i>\n\t</TD>\n</TR>\n",
+		Mtime: 1792132491,
+		Hash:  "21044d7b043795cf2dda6fc922cd18f8e612a30ddd799cfee26b941f54e6e771",
+	},
+	"templates/to_extend.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|ͱ\xcaB1\f\x05\xe0\xf9\xfeO\x11\xb2\xff}\x00\xa5\x8e>\x81\x9b8\x14\x1bm\xa16\xa5\xc9p%\xe4\xdd\x05\x95;\xba\x1d8\x1f\xe7,fB\xaa\xb5\xdf\x05\xb0s\xa60&\xdd\xea\x8a\xeef\xe18\xf9\xe1\xbe+\x942\xcd\xe5\xff\x00?\xf5\x897\vg\xd1g\xa3\x98\x93\x14\xcap\xe5\xc63\xa2\xd9;\x00NjI+w)u\x04Z\x95zFw\x04enZG\xc4\xed\x1b>\xad\xc0w\x1f/\xfb\xbf\xd7\x00\x8d\x1b\xd3ȴ\x00\x00\x00",
+		Mtime: 1792132309,
+		Size:  180,
+		Hash:  "091db61100de3b107aa66331df622ecd7bdc5dccd7c42927c56b5341d8d1cd24",
+	},
+	"templates/to_nested.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\xce1\xaa\x02A\f\xc6\xf1z\xdf)B\xfa\xb7\ax\x8f\xb1\xf4\x04vb1n\xa2\x1b\x9cM\x96L@e\x98\xbb\v\"[\xda}\xc5\x0f\xbe\xff\xd0Z\xe5\b\xd1k\x05T#\x1eW\xe7\x8b<\xb0\xf7\xd6ƽ\xdb\xd2\xfb\xdf̙؇\xdf\x1d|\xd5\a\xdb,\x1ck<\v'\xb2\b& \xf1t\xce\xd3\r\xb2\xbb\xdd#KIF\x92\x17S\x82Ɋy\xc2\xd6\xde\x03й\xe4\x10\xd3:\xcb:*\xd7`\xc2\xde\x11¬\x84\xac\t\xb7.\x98L#\x8bV\xf8\x9c\xe3\xe9\xff\xe75\x00&\xe5\x81\x05\xd1\x00\x00\x00",
+		Mtime: 1792132576,
+		Size:  209,
+		Hash:  "01122a21a006c8c46421059d4e76b7d174cc89bbf30538f2ae60252c4ccad638",
+	},
+	"templates/nested_begin.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8eA\xaa\xc30\fD\xd7\xce)\x84\x0f\x90\v\x84\x9c\xe0ç\xd0\x03\x04'\x11u@\x8d\x8c\xa4,\x8a\xd1\u074bq\xe8n\x98\xf7\x18&\x04\xbd֗\xa4\x92a\xa3K\re9Q\r\xf7\xa5\xd6\xf1!l\xfc\x9f\xde\xf8ǚQܡ\x0e!\x04J+\x12\xcc\x10k\x1d\x9f\x99Ś\xe2\x1e\x1b3f\xb2\xa3\xdc\xf47pS\xb5\x0f!̰'\u0378O\xadژX\xba\xdec\x14\xa4d\a\x9f\x9a\x8f2\xf63\xd1=N\xc3\xf0\x1d\x00TW\x8e\xe2\xad\x00\x00\x00",
+		Mtime: 1792132576,
+		Size:  173,
+		Hash:  "bc0b10d3a96015dde0e3995550947ac13f66e61af2f9abec6e9cf1f92df26707",
+	},
+	"templates/nested_end.tmpl": {
+		Data:  "\t\t}\n\n",
+		Mtime: 1792132576,
+		Hash:  "f2f437d0ee5c587d26882de9d0a243e86f32c6d4f010239d0f29a7bad7dec6e9",
+	},
+	"templates/plantuml/extend_prefix.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff4\xcb1\x8a\xc30\x10\x05\xd0z}\x8a\x8f\xaa\xddF{\x01\xa1f\xfb\xedr\x00a\x8d\x93\x01{\xe4D\n1|\xe6\xee)L\xda\ao^K\xef\br\f\xb1\n2\xfe\x97M\xdc\x03J\a\xd9e\f\xb5kG\xb0V%\xee\x0fY\xf4\b\xeed\xbc\x98ޟ⎔Μ38\x91\xba\xa0XE\xfck\xdb&6\xf0\xdd\xf6\xa1\xcd\x10\xfa\xad\xbd0\x9fڡ\xb6\xaaI\xf8q\xff⢲VG\xd2L~\x9e{\xfa\xd5<\x91b\xd5}z\x0f\x00\xbdH\x8bc\xa7\x00\x00\x00",
+		Mtime: 1792132309,
+		Size:  167,
+		Hash:  "41a17c51b83d3fde17d4f52ec10dab92176117801f52c8a3772665b7509251a8",
+	},
+	"templates/plantuml/message_extensions.tmpl": {
+		Data:  "\t.. <back:{{color \"extensions.background\"}}>{{.Name}} {{.Type}}</back> ..\n",
+		Mtime: 1792132309,
+		Hash:  "43906c8373d956bc8ba2b2b646db294fadc5f58c7add4b28834d79941c485fca",
+	},
+	"templates/plantuml/message_reserved.tmpl": {
+		Data:  "\t.. <back:{{color \"reserved.background\"}}><i>{{.Name}} {{.Type}}</i></back> ..\n",
+		Mtime: 1792132491,
+		Hash:  "21a1df6c0379de850cb6a4f1c8c7d90b15b32541b73e87c9379649b85bc96493",
+	},
+	"templates/plantuml/to_extend.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xaa\xae.N-)\xc9\xccK/VP\xca\xcbOI\xd5+(JMˬP\xaa\xad\xad\xae\xd6s+\xcaϭ\xadUЋ\xae\xaeN\xce\xcf\xc9/RP*J\xcdI,\xc9\xcc\xcf+\xce\xc8,\xd0K\xad(I\xcdKQ\xaa\xad\x8dի\xb1S\xc0kRH~m\xad\x82\x95\x02DG1\x17`\x00֤+~v\x00\x00\x00",
+		Mtime: 1792132309,
+		Size:  118,
+		Hash:  "f6b45068cbab0283ff2840e9c38f0d79ec90a57ba6bf71a501c8cdec0b5719c8",
+	},
+	"templates/plantuml/to_nested.tmpl": {
+		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xaa\xae.N-)\xc9\xccK/VP\xca\xcbOI\xd5+(JMˬP\xaa\xad\xad\xae\xd6s+\xcaϭ\xadU\xd0ҍ\xae\xaeN\xce\xcf\xc9/RP*J\xcdI,\xc9\xcc\xcf+\xce\xc8,\xd0\xcbK-.IMQ\xaa\xad\x8d\xd5U\xc0kPH~m-\x17`\x00\x1c\xa8\xa7`k\x00\x00\x00",
+		Mtime: 1792132576,
+		Size:  107,
+		Hash:  "29829e17d899d379718f3e93b567d785f891fe5bdde727415eb3ac992f1370a5",
+	},
+	"templates/plantuml/nested_begin.tmpl": {
+		Data:  "' {{.ProtoName}} and its nested types\n",
+		Mtime: 1792132576,
+		Hash:  "540c97cdbb362f8238b4eb2d53502423081a6e637d6699fab18b7b1140fb5f32",
+	},
+	"templates/plantuml/nested_end.tmpl": {
+		Data:  "' end of {{.ProtoName}}\n",
+		Mtime: 1792132576,
+		Hash:  "32543477793e0354a6e2cb044d3d54971ac06e7c69a07661e5d25c73933cfab6",
+	},
+}
+
+func init() {
+	assets.Assign(staticAssets)
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphviz runs the 'dot' utility (a part of graphviz) over the produced .dot sources.
+package graphviz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+)
+
+// Convert runs 'binary' on the in-memory .dot source, e.g. format = "svg"
+func Convert(ctx context.Context, binary string, dot []byte, format string) ([]byte, error) {
+	if len(binary) == 0 {
+		return nil, errors.New("the 'graphviz' location is empty")
+	}
+
+	cmd := exec.CommandContext(ctx, binary, "-T"+format)
+	cmd.Stdin = bytes.NewReader(dot)
+	return cmd.Output()
+}
+
+// Generate runs 'binary' on the given .dot file and saves the result next to it. returns the name of the produced file
+func Generate(binary, src, format string) (string, error) {
+	if len(binary) == 0 {
+		return "", errors.New("the 'graphviz' location is empty")
+	}
+
+	output, err := exec.Command(binary, "-T"+format, src).Output()
+	if err != nil {
+		return "", err
+	}
+
+	target := src + "." + format
+	if err := ioutil.WriteFile(target, output, 0755); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// Action runs the custom action, passing the names of the produced files in PROTODOT_* environment variables
+func Action(action, dot, svg, png string) ([]byte, error) {
+
+	envs := os.Environ()
+	envs = append(envs, "PROTODOT_DOT=\""+dot+"\"")
+	envs = append(envs, "PROTODOT_SVG=\""+svg+"\"")
+	envs = append(envs, "PROTODOT_PNG=\""+png+"\"")
+
+	cmd := exec.Command(action)
+	cmd.Env = envs
+
+	return cmd.Output()
+}
@@ -0,0 +1,55 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/seamia/protodot/api"
+	"github.com/seamia/protodot/core"
+	"google.golang.org/grpc"
+	"net"
+)
+
+type daemon struct {
+	session *core.Session
+}
+
+func (d *daemon) Render(ctx context.Context, req *api.RenderRequest) (*api.RenderResponse, error) {
+	if len(req.Source) == 0 {
+		return nil, errors.New("no source provided")
+	}
+
+	d.session.Status("rendering request; selection: [", req.Selection, "], svg:", req.Svg)
+	// the source is always taken as a blob: the clients do not get to read the files of the host
+	source := asBlob(req.Source)
+	var response api.RenderResponse
+	var err error
+	if req.Svg {
+		// same as the http daemon: falls back to the native renderer when 'graphviz' is not available
+		response.Dot, response.Svg, err = d.session.RenderSvg(ctx, source, core.Options{Selection: req.Selection})
+	} else {
+		response.Dot, err = d.session.Render(ctx, source, core.Options{Selection: req.Selection})
+	}
+	if err != nil {
+		d.session.Alert("failed to render", err)
+		return nil, err
+	}
+	return &response, nil
+}
+
+// running protodot as a long-running service, listening on the given address (e.g. ":50051")
+func grpc_main(s *core.Session, address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	api.RegisterProtodotServer(server, &daemon{session: s})
+
+	s.Status("listening on", address)
+	return server.Serve(listener)
+}
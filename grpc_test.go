@@ -0,0 +1,61 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"github.com/seamia/protodot/api"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDaemonRender(t *testing.T) {
+	d := daemon{session: newTestSession(t)}
+
+	response, err := d.Render(context.Background(), &api.RenderRequest{Source: testProto, Selection: "Item", Svg: true})
+	if err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if !strings.Contains(string(response.Dot), "digraph") || !strings.Contains(string(response.Dot), "Item") {
+		t.Errorf("unexpected .dot:\n%s", response.Dot)
+	}
+	if !strings.Contains(string(response.Svg), "<svg") {
+		t.Errorf("unexpected .svg:\n%s", response.Svg)
+	}
+
+	response, err = d.Render(context.Background(), &api.RenderRequest{Source: testProto})
+	if err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if len(response.Svg) > 0 {
+		t.Errorf("got .svg without asking for it")
+	}
+
+	if _, err := d.Render(context.Background(), &api.RenderRequest{}); err == nil {
+		t.Errorf("no error for the empty source")
+	}
+}
+
+func TestDaemonRenderNoFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "protodot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "order.proto")
+	if err := ioutil.WriteFile(name, []byte(testProto), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// a file name is taken as the (invalid) .proto source, not read from the disk
+	for _, source := range []string{name, filepath.Join(dir, "*.proto")} {
+		if response, err := (&daemon{session: newTestSession(t)}).Render(context.Background(), &api.RenderRequest{Source: source}); err == nil {
+			t.Errorf("rendered [%s] from the disk:\n%s", source, response.Dot)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/seamia/protodot/core"
+	"github.com/seamia/tools/support"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	entrySources = "sources" // location of the .proto files served by '/file' endpoint
+	maxBlobSize  = 4 << 20   // the largest .proto source accepted by '/blob' endpoint
+)
+
+var format2contentType = map[string]string{
+	core.FormatDot: "text/vnd.graphviz; charset=utf-8",
+	core.FormatSvg: "image/svg+xml",
+	core.FormatPng: "image/png",
+
+	core.FormatMermaid:  "text/vnd.mermaid; charset=utf-8",
+	core.FormatPlantUML: "text/plain; charset=utf-8",
+	core.FormatJSON:     "application/json",
+}
+
+type httpServer struct {
+	session *core.Session
+	sources string // root of the .proto files served by '/file' endpoint
+}
+
+// POST /blob?select=...&depth=...&exclude=...&format=...  with the body containing .proto source
+func (h *httpServer) handleBlob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBlobSize))
+	if _, tooLarge := err.(*http.MaxBytesError); tooLarge {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.respond(w, r, asBlob(string(body)))
+}
+
+// the pipeline tells blobs apart from the file names by the number of lines
+func asBlob(source string) string {
+	if strings.Count(source, "\n") <= 1 {
+		return source + "\n\n"
+	}
+	return source
+}
+
+// POST /file?path=...&select=...&depth=...&exclude=...&format=...  with the path relative to the configured 'sources' location
+func (h *httpServer) handleFile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	root := h.sources
+	if len(root) == 0 {
+		http.Error(w, "the 'sources' location is not set", http.StatusNotFound)
+		return
+	}
+
+	name := r.FormValue("path")
+	if !strings.HasSuffix(strings.ToLower(name), ".proto") {
+		http.Error(w, "'path' must point to a .proto file", http.StatusBadRequest)
+		return
+	}
+
+	// do not let the requests escape the 'sources' location
+	full := filepath.Join(root, filepath.Clean(string(filepath.Separator)+name))
+	if !support.Exists(full) {
+		http.Error(w, "file ["+name+"] not found", http.StatusNotFound)
+		return
+	}
+	h.respond(w, r, full)
+}
+
+func (h *httpServer) respond(w http.ResponseWriter, r *http.Request, source string) {
+	selection := r.FormValue("select")
+	depth, _ := strconv.Atoi(r.FormValue("depth"))
+	exclude := r.FormValue("exclude")
+	format := strings.ToLower(r.FormValue("format"))
+	if len(format) == 0 {
+		format = core.FormatDot
+	}
+
+	contentType, found := format2contentType[format]
+	if !found {
+		http.Error(w, "unsupported format ["+format+"]", http.StatusBadRequest)
+		return
+	}
+
+	h.session.Status("rendering request; selection: [", selection, "], format:", format)
+	data, err := h.session.Render(r.Context(), source, core.Options{Selection: selection, Format: format, Depth: depth, Exclude: exclude})
+	if err != nil {
+		h.session.Alert("failed to render", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(data)
+}
+
+func newHttpHandler(s *core.Session, sources string) http.Handler {
+	h := httpServer{session: s, sources: sources}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/blob", h.handleBlob)
+	mux.HandleFunc("/file", h.handleFile)
+	return mux
+}
+
+// running protodot as an http server, listening on the given address (e.g. ":8080")
+func http_main(s *core.Session, sources, address string) error {
+	s.Status("listening on", address)
+	return http.ListenAndServe(address, newHttpHandler(s, sources))
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/seamia/protodot/core"
+	"github.com/seamia/tools/support"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testProto = `
+syntax = "proto3";
+package demo;
+
+message Order {
+	Item item = 1;
+}
+
+message Item {
+	string name = 1;
+}
+`
+
+func newTestSession(t *testing.T) *core.Session {
+	config, err := support.LoadConfig(configDefaultName, false)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	s, err := core.NewSession(config, "")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	return s
+}
+
+func newTestHandler(t *testing.T) http.Handler {
+	s := newTestSession(t)
+
+	sources, err := ioutil.TempDir("", "protodot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(sources) })
+	if err := ioutil.WriteFile(filepath.Join(sources, "order.proto"), []byte(testProto), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return newHttpHandler(s, sources)
+}
+
+func TestHttpHandler(t *testing.T) {
+	handler := newTestHandler(t)
+
+	cases := []struct {
+		name        string
+		method      string
+		target      string
+		body        string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{"blob", http.MethodPost, "/blob", testProto, http.StatusOK, "text/vnd.graphviz; charset=utf-8", "Order"},
+		{"blob selection", http.MethodPost, "/blob?select=Item", testProto, http.StatusOK, "text/vnd.graphviz; charset=utf-8", "Item"},
+		{"blob json", http.MethodPost, "/blob?format=json", testProto, http.StatusOK, "application/json", "demo.Order"},
+		{"blob mermaid", http.MethodPost, "/blob?format=mermaid", testProto, http.StatusOK, "text/vnd.mermaid; charset=utf-8", "Order"},
+		{"blob too large", http.MethodPost, "/blob", strings.Repeat("/", maxBlobSize+1), http.StatusRequestEntityTooLarge, "", ""},
+		{"blob unknown selection", http.MethodPost, "/blob?select=Nothing", testProto, http.StatusUnprocessableEntity, "", ""},
+		{"file", http.MethodPost, "/file?path=order.proto", "", http.StatusOK, "text/vnd.graphviz; charset=utf-8", "Item"},
+		{"file clamped to sources", http.MethodPost, "/file?path=../../order.proto", "", http.StatusOK, "text/vnd.graphviz; charset=utf-8", "Item"},
+		{"file not proto", http.MethodPost, "/file?path=order.txt", "", http.StatusBadRequest, "", ""},
+		{"file missing", http.MethodPost, "/file?path=other.proto", "", http.StatusNotFound, "", ""},
+		{"blob bad method", http.MethodGet, "/blob", "", http.StatusMethodNotAllowed, "", ""},
+		{"file bad method", http.MethodGet, "/file?path=order.proto", "", http.StatusMethodNotAllowed, "", ""},
+		{"bad format", http.MethodPost, "/blob?format=gif", testProto, http.StatusBadRequest, "", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			request := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != c.status {
+				t.Fatalf("status: got %d, want %d (%s)", recorder.Code, c.status, recorder.Body.String())
+			}
+			if len(c.contentType) > 0 && recorder.Header().Get("Content-Type") != c.contentType {
+				t.Errorf("content type: got %q, want %q", recorder.Header().Get("Content-Type"), c.contentType)
+			}
+			if !strings.Contains(recorder.Body.String(), c.contains) {
+				t.Errorf("body does not contain %q:\n%s", c.contains, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plus
+
+import (
+	"bytes"
+)
+
+// Table accumulates the rendered rows of a single node of the diagram
+type Table struct {
+	buffer    bytes.Buffer
+	templates *Templates
+}
+
+func (t *Templates) NewTable() *Table {
+	return &Table{templates: t}
+}
+
+func (t *Table) Write(p []byte) (n int, err error) {
+	return t.buffer.Write(p)
+}
+
+// Apply renders template 'name' at the end of the table
+func (t *Table) Apply(name string, payload interface{}) error {
+	return t.templates.ApplyTemplate(name, t, payload)
+}
+
+func (t *Table) String() string {
+	return t.buffer.String()
+}
@@ -16,8 +16,6 @@ import (
 	"text/template"
 )
 
-var g_preloadedTemplates map[string]*template.Template
-
 func loadExternals(name, tmplDir string) (string, error) {
 
 	if len(tmplDir) > 0 {
@@ -78,29 +76,35 @@ func resolveExternals(text, tmplDir string) (string, error) {
 	return text, nil
 }
 
-func PreloadTemplates(config map[string]interface{}, funcs template.FuncMap, tmplDir string) error {
+// set of the parsed templates, safe for concurrent use
+type Templates struct {
+	preloaded map[string]*template.Template
+}
+
+func PreloadTemplates(config map[string]interface{}, funcs template.FuncMap, tmplDir string) (*Templates, error) {
 
-	g_preloadedTemplates = make(map[string]*template.Template)
+	preloaded := make(map[string]*template.Template)
 	for name, data := range config {
 		if text, err := resolveExternals(data.(string), tmplDir); err == nil {
 			if tmpl, err := template.New(name).Funcs(funcs).Parse(text); err != nil {
-				return err
+				return nil, err
 			} else {
-				g_preloadedTemplates[name] = tmpl
+				preloaded[name] = tmpl
 			}
 		} else {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return &Templates{preloaded: preloaded}, nil
 }
 
-func ApplyTemplate(name string, where io.Writer, payload interface{}) error {
+func (t *Templates) ApplyTemplate(name string, where io.Writer, payload interface{}) error {
 
-	if g_preloadedTemplates != nil {
-		if tmpl, present := g_preloadedTemplates[name]; present {
-			return tmpl.Execute(where, payload)
-		}
+	if t == nil || t.preloaded == nil {
+		return errors.New("templates are not available")
+	}
+	if tmpl, present := t.preloaded[name]; present {
+		return tmpl.Execute(where, payload)
 	}
-	return errors.New("templates are not available")
+	return errors.New("the template is not configured")
 }
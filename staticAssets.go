@@ -1,223 +0,0 @@
-// *** DO NOT EDIT ***
-// This file was generated by github.com/seamia/tools/assets/cmd/assets
-// on Saturday, 09-Feb-19 17:16:01 PST
-package main
-
-import "github.com/seamia/tools/assets"
-
-var staticAssets = assets.AssetRoot{
-	"config.json": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94WMo\xdc6\x10=ˀ\xff\x83@\xe4\xd8j\x1d;\r\x90\xbd\xf5P4\x87\xb6)\x8a\xf6T\x14\v.5\xd2\x12\xa6H\x96\x1fv\x1cc\xff{AR\x94(\x8a\xdau}2v\xde{\x1c\xbe\x19\x0e\xa9\xd7ۛ\n\xb5\x82\xd8\x01\xb8\xc1\x86\n\x8e\xf65:\x19#\xf5~\xb7\xeb\xa99\xd9cCİӀ\a\x8awR\t#Za\xd0w\x8e\xa8\xc1\x18\xca{\x8d\xf6\xb5\x13\xaa\x90Pt֩*\xf4\xcb\x1f\x1eX!.Zh\xf4\tK\xf0\xbfK\x86)7\xf0դ\xe1Np\xd3h\xfa\xcdA\xd0\xfb\xbbU\x88\xe3\xc1\x87\xfe:Znl\b{\x88\x13j0\xa3=oN\x80[P\x0e\xa5h\u007f\x8a\xf2\t@ÿ\x168\x81\v\x90\xb8\x0e\x83\xae\x105/\xf2\x12Y\x81\x04l.\x00\x9e0\xb3\x17\xe4\x05\aѥ\xe1\xd9\x04\xa9\xa0\xa3_\xbd\u007f\xbf\x89\x16\x0e\xe8\xf6\xa6:\xfb:\x18\x18$\xc3\x06\xe6BĒ&\x86t\x94\xc1~B\xee\x8e\xd0SޘA\xb21\t\xe0F\xbdx\xf5\f\xe9\x03)r\x12\xef\x840Eq\xe0\xedD\xf0\x14\rꉒd\x0f\x81!80\xcaS\xe6\b<\x04`\xbaj\x94P\x92\xccYn\v(IJlm\xbb4\x815/\x00\x96\xd9\x13f\xb5\x01\x95g\x9f\x90\xed\xb1WX\x9e\x0e+W#5\xba\xbb\xc9\\\xb9\x1c\x99\xdb\x19\xcf\xd4\xcc\xedN\x89\xa11\xa2\x19@k\xdcC\x81k\xc4a\f\xa6KF\x1ep;\x94:\xc1\x88\x83\v\x95(\x03՚\xf2~c\xa9\x10Lx\x9e\x1b3\xb8\xda\x14#\xb0\xd0\x14QbӤH-\x955X\xae\xe9 \x19\\j)\x8f;\x04\xdc\xea\xc4dfm\xb1s\xdf\x027/\xd0\x16\xbbP\xabQ \xb3}S\xa0\\\x01\x9fU2W65\xecP0߳\xb3\xb1\xb1A^u\xb7\xe7NU+\f\x10;\x14k6`\xf9\x86\x8a\rX\x16\xea\xe5\xb8ת\xe5\x98y\xad\x1co\xae\xd4Ej\xa1P\x9e=\x95\xe92;\xabR\xb8Sݍ\x10l\xbe~R<8\xb8](X*5\x99\xf8\x16\xa9\xb5\x97\xa9\xd4\xe8\xe9[\x84rkS\x99\xeb\x87!U*8\xbd\x10\xbbz0\x16b\xab\xe3\xb1\xf4j9]\xaex\xb5\xec\xda\xf1\xa4\xd1A\ne\xf4\xff\xb8\x8f#\xc3\xdd\xfd\xa5#\x12\xe2\a\xff4ؠ]\x98\xca\t\xbb\xb4\xfb\xa8B\x04\xe7@\xc6\xf7܆\x06\xa3\xfc\xb1\xc4}\xfb\xeb &\xb0\xb5\xd51\xbe\xda+\x11\x83{\x87\x94(c(\xa0\xa7\x87\x12\x11L\xa8\xf9\x95t\xc4\xe4\xb1W\xc2\xf2\xd6K<\x9f\xa8\x81\xe4I\xe6~\xacБa\xf2\x98\xdd\xc9\v\"j\xb1z\x14\x8c>A\xaf\x00\xf8\xfb\f\x1b\x95\x16B\n\x98\u007f%\xeb\x13Mg\xcb6&\x9e1\xbfĢE\x97\xb9HL\x15\xb4\x9f\xf6\x1f\xe3>^$\xa4\x033\xc6\xef\xd2\xf84\x14c\xf4>\x8d\xa6\xa3/\x02>,\x00\xc9t\xeb\x15\xbc\xe8O\xfb\x87\xec~^&\xd91\xa10K편\xf9xĕ~H/\x8c\xf2^\xefSȤ0\xc7\x1f\xb27\xe0R\xa5\x17\xac͟\x98`\xac\xf2\x1dO\\\xa2Yt\xce1\x8d.+:\x9f\xbbѐ\xb8\x8d\xd8\xea\xb3\xc8\b\xf8\x90\x01\xb2$\x03\xe8~ne&HXo\xeaf\xff\x18|\xa2\xdfоv\u007f(~\xa6U\xa8\a\x0e\n\x1bhѾ\xaeл\xd7\xcf_~\xfd\xe9<}\xcb\xed\xe6p\xec\xfd\xf9s\xc2\xc1\x89\xe0\x1d훖\xaa\xf3\xf4\x05\xf0̙\xc0\xad.\v\xce\xe1\x00\xc7\xe3\x04\xa9\xc3\x1f\x9aw!d\xd8C\x1d7\x81\x19\x13\xcf\xf5hB=\x8e\x12\xb4\xaf\x8c\xb20\x96\xe1\x94\x00\\\xff\xb9p\x12\x8f\xbb\xa9\x1b\xc9\xfbڍ\x05\x17\xef0\xd3+\x80~\x9a\x01\xc9\x02VJ\x05Zט\xb1ZX#\xad\x99\x14\xa6\xc4)'̶ޢ\xbf=ke\x82\xff\x87|\xff\xd0|l\xeev#|\xf4\xe3\xdd\xeb\xcf_~\xff\xf1\xcf\xcf\xe7\x9dV$\xfd\xca\xeeE/\x02\xf1h\xbb\x12\xd89\xf7\xcf\xed\xcd\xf9\xbf\x00\x00\x00\xff\xff\xed\xf5\xdd-\xb8\x0f\x00\x00",
-		Mtime: 1549761173,
-		Size:  4024,
-		Hash:  "59812d871a777d377dcef8dd1d686f979cc3625eec8754a6548b6d918968cee5",
-	},
-	"templates/begin.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xffl\x8f\xc1j\xc30\f\x86\xcf6\xf8\x1d\x84\x8f\x85%\xf7\x96\xbc\xc3`\xec4vPb-5M\xa5`+\x87-\xe4݇\xb2\x15Z\xe8Q\xff\xf7\xfd\xfcv{\b\xde%\x01\x16\x05JY\x8f\xc1;\\T^Fb*\xa8\x94\xa0\xff\x861\xeby\xe9\x9bA\xaem%\xbcfl\xe7\"*I4\xf8C\x1b|\xcac\xc1\xf9\f\xb7\x14\xd6\xe0\x83w\xed\x01f\x1c.8\xd2\x11\x00ֵy\xfd\xbb\xb6\r\xace\xbc\xcaR\x86\x1d\xef\xdc\xfa\x8c\xd7\a\x83&\x1a4\v\x1f\xcdx\xbb]\xffF\xf0\xae _R.ݺVR\xcd<V\x88R2\xb1\xa2\x89q\xdbN\xc1\xbb\t{\x9a\xbax\xff\x88h\xb9\x8aL\x9a\xe7'\xa4\x1f\a\x99\xa4tQ\vr\x9d\xb1\x10k\xdc\x17Y\x12\xc1G\xf0\xce\xd53\xce\xf4\xb0l\xac\xd9\xe3\xb8m\xa6|\tk\xcd?O,#\x8d\xa1;\xd3>\xdf\xc5\xf7~a]b\xf0\xee\xf3d\x8b\xbf\x01\x00\x00\xff\xff\n\xa5S\xf9\xa6\x01\x00\x00",
-		Mtime: 1537050768,
-		Size:  422,
-		Hash:  "71db7291efd0c5cc2a6f47ccd18e6f6b704fe214281218b9b04771c370ee592b",
-	},
-	"templates/comment.tmpl": {
-		Data:  "\r\n\t/* ------ {{.}} ------ */\r\n",
-		Mtime: 1535906829,
-		Hash:  "c97118cdf5b95d931d23ee3fd0d343da8c1a7c9966b454d02652262b0cb614c6",
-	},
-	"templates/end.tmpl": {
-		Data:  "\r\n\t/* {{.AppVersion}} on {{.Timestamp}} */\r\n}\r\n",
-		Mtime: 1536168181,
-		Hash:  "cc28a3e36ba3f17ac678316c0d2bec6fdc151d353289bdebad37a47c5f7a6ca6",
-	},
-	"templates/entry.tmpl": {
-		Data:  "\t{{.}}\r\n",
-		Mtime: 1535750656,
-		Hash:  "99077f95426d6fa3d7d7c6a805298e8b1658cf7b7567e3d34a7becb5d4a9a21e",
-	},
-	"templates/entry_enum.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xd0a\x8a\x84 \x18\xc6\xf1\xcf\x05\xddA<\x80]\xc0\x82\xdd\rb!2\xc2\vH\xfbn\be\xae)\x14\xe2\xdd\x17\x1d&\x98O3s\x80\xdf\xf3\x87\x87\xf2\xb1.\xf2\x8c\xf2\x06}t\xdfm_a\xefw\xb0V\xaayG\xd8\xc2a\x89X䬈\x01\r\xc2\xe2\x10p\xed=\x19\f\xfc\xca#\x04Z\xf2\xe65\xbfß\x035\xc1}\x81\x99\x1f\xa9\xc4\xf2΄\x12\xeb\xc5{\xb1£\xfdl\xbfX\xc7ƨ\xa7m\xd9\f\xc2\xf6\xd4@@\xb95\"4\xb0\x91WXo\x17\xc6Or\x91\xa7\\\x91g\x19u\xb1\xcaO\x9d\xaa.EoqZ\xa6\v\xff\x03\x00\x00\xff\xffw4\xa9\x04I\x01\x00\x00",
-		Mtime: 1536106492,
-		Size:  329,
-		Hash:  "e73168c3cd625407f93551e9ac64e9c3a0e30e4122ac40c1f27f19f9dc19a50c",
-	},
-	"templates/entry_message.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xd0a\x8a\x84 \x18\xc6\xf1\xcf\x05\xddA<\x80]\xc0\x82\xdd\rb!2\xc2\vX\xfbn\be\x8e:P\x88w\x1fr\x98`>\xcd\xcc\x01\xfe\xcf\x0f\x1e\xca\xfb2K\x13\xca+\xf4\xd5\xfc\xd6m\x81\xbd\xb7\xe0\x9cT\x93E\xd8\xc1战夈\x01\r\xc2\xe1\x10p\xe9=\xe9\f\xfc\xcb-\x04\x9a\xf3\xea\xbd\xde\xc2\xe5\nj\x84\xc7\x023\u007fR\x89\xf9\x93\t%\x963o\xc5\x02\xcf\xedw\xfd\xc3\x1a\xd6\x1f\xf5\xb8ΫA\xd8\xed\x1a\xc8\x02֊)v\xa8c=/\xb0^\xcf\x1e\xbf\x10\x8f\x85(fi\x92\xd0\xe1\x80\xf9\xae#<D\xf7\xee\xd3<\xbex\v\x00\x00\xff\xff\xda\xfe\xb2\x17L\x01\x00\x00",
-		Mtime: 1536106517,
-		Size:  332,
-		Hash:  "50c8b7c1a21f830ac8d164446cce29c79cdb345b6b09ee5c58466f2e5a828005",
-	},
-	"templates/entry_missing.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xd0a\x8a\x84 \x18\xc6\xf1\xcf\x05\xddA<\x80]\xc0\x82\xdd\rb!2\xc2\vX\xfbn\be\xae\xbaP\x88w_t\x99`>\xcd\xcc\x01\xfe\xcf\x0f\x1e\xcaǺ\xc83\xca\x1b\xf4\xd6}\xb6}\x85\xbd\xb7\xe0\x9cT\x8bE\xd8\xc1\xe1\x88X墈\x01\r\xc2\xe1\x10p\xed=\x19\f|\xcb#\x04Z\xf2\xe6\xb9\xde\xc2\xcf/\xa8\x19n\v\xcc|I%\xd6W&\x94خ\xbc\x17\x1bܷ\xef\xed\a\xeb\xd8\x18\xeby_w\x83\xb0;5\x90MZ+\xd5\x12;4\xb0\x91WX\xefW\x8f\x1f\x88q!\x89E\x9eet\x8a0?u\x82\xa7\xe4\xfe\xfb\xb4L/\xfe\x05\x00\x00\xff\xffXh\x93\xbcL\x01\x00\x00",
-		Mtime: 1537289571,
-		Size:  332,
-		Hash:  "d18cc21335a58aad183960182ccc02328ffdf65166dfa640119ea81832cf2630",
-	},
-	"templates/entry_simple.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xceQj\xc4 \x10\x06\xe0\xe7-\xf4\x0e\xe2\x01\xcc\x05\\\xa1\xed\x96\x10\b1\xc8\\@\xd2i\x10\x8c\xb1j!A\xbc{1\xa5\x81>\xb5\xfb:\xcc\xf7\xff?\a%\x1e\x1f.\x1cn\xe4\xa9\xef\xda\xe1Js\x8e\x98\x92qs$4ᖘ\xb6fv,\xa0G\x9dh)T\xe4\xccƀ\xeff+\x857p\xfb\x9f\x8f\xf8\xf1\x89n\u009f\x04\x19ތ\xd3\xf6\x9e\b\xa7\x97\x93\x0fz\xc1\xdf\xf6\xb9}\x91\xbdTUO\xab]\x03\xa1i\xf7ȢY\xbc=\x18\x19\xa5\x82+\xf5\xeb\xc9\xe9\x1f\x855\xe0\x90\xd0A\xffZ\xdf\x18\xec\xbe\xc2Zz\xe1F\x9c\x17ޘc\xc8\xf7 ހ\x12_\x01\x00\x00\xff\xff4\xf6\xee\xb8[\x01\x00\x00",
-		Mtime: 1536169334,
-		Size:  347,
-		Hash:  "4009b6da815b2d80c79a3f671d43f0b042317bfbfda647b5a671b1c0973b4461",
-	},
-	"templates/enum_entry.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb2\t\t\xb2\xe3\xe5\xe2\xb4\tqQprw\xf6\xf7\xf1\x0f\xb2U\xaa\xaeN\xce\xcf\xc9/RPJ\xcd+\xcd\xd5KJL\xceN/\xca/\xcdKQ\xaa\xadURp\xf4\xf1t\xf7\x03\xa9)N-)\xc9\xccK/VP*I\xad(\xd1K\xcc\xc9L\xcf\xd3\xcbK\xccM\x05)\x03\x99\xc9Y]\xad痘\x9bZ[\v\xb2@?ą\x9a\x16\x95%攢\xd8\x14\x06\x12@\xb6\xcaF?$\xc8\x0e\x10\x00\x00\xff\xff\xeb\xddG\x80\xdf\x00\x00\x00",
-		Mtime: 1535766707,
-		Size:  223,
-		Hash:  "a8bbde5ae099dd68dbefddc92f8ba6c0020cd9e99bf24c2a8e38be0e3b4acf40",
-	},
-	"templates/enum_prefix.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x8f\xcdj\xc30\x10\x84\xcf\x0e\xe4\x1d\xc4>\x80\xd3\xf6,\x05\xe2\x1fL\xc0\xd8AUO=\xc9\xf1\xd6\x11U$ז! \xf4\xeeEN\xff.=-\xc3\xec\xce\xce\xe7\xfd\x8c\xce)3\xcc\x04\x8c\xed1\x1d'|S7\b\xc1\xfb\xf4Ũ\x8f\x05CH^\xe7\x8b\x1c\x91\x8dZ*\xe3\xf0戳V;52\xf0>m\xe4\x15C\x00\xa2e\x87\x9a\xd1톊CV\x97$kyQr\x06\x8f@\U000b2bbf\xe5\xc3]>\x9f\x0e\xf9\xb1\xa9V\x9dUy[\xb7<\xa6\x9d\xad\xb6\x13\x014\xcb5\xed\xe4\xf9}\x98\xecbz\b\x01\xf6\xdbMB\x05\x8f#\xa1\xa2 y\x1b3\x1a\x06O@N-\x17\f.({\x9c\xfe\x8d\xfb\xb2c\xd5C}\xac\x9a\xe8\xff\xc2G\xacTj5\x98?\x8b\xeb\xb3$^\x13\xda\xed\u007fX鮻\xd7؉b\xad\xb5\x13|\xff\x19\x00\x00\xff\xff[3z\xf1J\x01\x00\x00",
-		Mtime: 1536355808,
-		Size:  330,
-		Hash:  "e48e5bbeb429baf35e2594a3bf773f6c12d539477133318b9a303b99b3b6adc5",
-	},
-	"templates/enum_suffix.tmpl": {
-		Data:  "</TABLE>>];",
-		Mtime: 1536168653,
-		Hash:  "02177f2768fe5a0168d8d94e179b521d5164217e37a36a35f5215946b30d7583",
-	},
-	"templates/import_link.tmpl": {
-		Data:  "\t{{.From}}\t-> {{.To}} [tooltip=\"\"];\r\n",
-		Mtime: 1537637913,
-		Hash:  "5c67727d4899f6cb5a0027eb037c1055f379423bd249303a2404a99e4a72617e",
-	},
-	"templates/import_node.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff⬮\xd6\xf3\xcbOI\xf5K\xccM\xad\xad\xe5\xe4\x8c.\xceH,H\xb5\xcd\xcb/IU\xc8ILJͱU\xaa\xae\xd6\vHL\xceNL\x87*\x8aɫ\xae\xd6s\xcb́r\x95\x14\x14J\xf2\xf3sJ2\v0\x95*)\x14\x97T\xe6\xa4ڦe\xe6䤦\xe8(\x80\xe8\xe4\xfc\x9c\xfc\"\xdb\xe4\xfc\xa2\xbc\xe2̜\xecXk^.@\x00\x00\x00\xff\xff\xa6\xc9w\xf6\x84\x00\x00\x00",
-		Mtime: 1537644177,
-		Size:  132,
-		Hash:  "0d1f3d0a28d27bd81737f53d6c75870d3383e5b1d270c2161bad5aad51f8cdfe",
-	},
-	"templates/import_node_missing.tmpl": {
-		Data:  "\t{{.NodeName}}\t\t[shape=note label=\"{{.FileName}}\" tooltip=\"{{.FileName}} is missing\" style=filled, fillcolor=lightpink];\r\n",
-		Mtime: 1537741997,
-		Hash:  "b95e06c4bf241ea0b9bd1b1e36c62057a5f25b233437d23a0dd769f7f2d6c56c",
-	},
-	"templates/map_enum.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x90Aj\x860\x10\x85\xd7\u007f\xa1w\bY\xb8*\xf1\x00F\xa1\xad \xa5bDr\x81`\xa7\x12Hb\xaa\t(!w/\xd1\x1f\xf7.g\u07bc\xf7>\x86\xf2\xa1z}yP^W!\x90~\x81_\xb9\xc5Hs^?\xd7\xe8\xbd\xfdj\xba\x12\x87\xb0\x82s\xd2L+\xc2\x0e6G\x84\x92\x93!+\xfcy0#\xe0\x18qJ`ˏ4B݉0B_\xf6Nh\xb8\xe3u\xbb=\xbc\xe8\xa3\xf9d-\x1b\xd2\xe18\xabyA8I\x04\x8cׇ\u07b3\x81\x97\xd8\xceW\aN\x05\x0f-l\xa6\\\x11\x02\xf9\x86\x9d\xef\x16b|C\xd4'\x94s\xa2\xb9\xaf\xb2\xc9\x15\x89椢\xf9\xf1\xb2\xff\x00\x00\x00\xff\xff\xac~0\xc89\x01\x00\x00",
-		Mtime: 1536106536,
-		Size:  313,
-		Hash:  "00fa74d04efe6f3e55ff0df5abcd4f0bb7a643c5e955bcb75b00517d326dd7d7",
-	},
-	"templates/map_message.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x90Mj\x850\x10\xc7\u05ef\xd0;\x84,ު\xc4\x03\xbc(\xb4\x15\xa4T\x8cH.\x10\xed4\x04\x92\x98\x9a\x14\x94\x90\xbb\x97hq\xefrf\xfe\x1f?\x86\xf2\xa1z~\xbaQ^W1\x92~\x81o\xb5\xa6D\v^\xff\xaf\xd1k\xfb\xd1t%\x8e\xd1C\b\xcaJ\x8fp\x805\x10\xa1\x95\xb4\xc4\xc3\xcf/\xd8\tpJ8'\xb0\xe5KY\xa1\xafDXaN{'\f\\\xf1\x86\xcd\xed^\xf4ּ\xb3\x96\rY8\xcdz^\x10\xce'b\xc0{!\x0fI\xcf\x06^b7\x9f58w܌pw\x1d\x1e1\x92O\xd8\xf8\xe6 \xa5\x17D\xc7LsL\xb4\x18\xab\xbb\f\x8f\ft\x80\xd1b\xff\xda_\x00\x00\x00\xff\xffVAD\x0f<\x01\x00\x00",
-		Mtime: 1536106547,
-		Size:  316,
-		Hash:  "488c3062f774ff2eeb7e7a2ed4b027a8d28fdcbd3f7724d3c5cff6bc19fe255c",
-	},
-	"templates/map_missing.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x90Mj\x850\x10\xc7\u05ef\xd0;\x84,ު\xc4\x03\xbc(\xb4\x15\xa4T\x8cH.\x10\xed4\x04\x92\x98\x9a\x14\x94\x90\xbb\x97hq\xefrf\xfe\x1f?\x86\xf2\xa1z~\xbaQ^W1\x92~\x81o\xb5\xa6D\v^\xff\xaf\xd1k\xfb\xd1t%\x8e\xd1C\b\xcaJ\x8fp\x805\x10\xa1\x95\xb4\xc4\xc3\xcf/\xd8\tpJ8'\xb0\xe5KY\xa1\xafDXaN{'\f\\\xf1\x86\xcd\xed^\xf4ּ\xb3\x96\rY8\xcdz^\x10\xce'b\x94\xf7\xca\xca]ҳ\x81\x97\xd8\xcdg\r\xce\x1d7#\xdc]\x87G\x8c\xe4\x136\xbe9H\xe9\x05\xd11\xd3\x1c\x13-\xc6\xea.\xc3#\x03\x1d`\xb4ؿ\xf6\x17\x00\x00\xff\xffc\xc8\v\\<\x01\x00\x00",
-		Mtime: 1537289396,
-		Size:  316,
-		Hash:  "dcd10959a438ad358c09ecc82676b6e8442316e37a82e27e7e961d4ec766cb67",
-	},
-	"templates/map_simple.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x90Mj\x850\x10\xc7\xd7\x16z\x87\x90\x85\xab\x12\x0f`\x14\xda\nR*F$\x17\bv*\x81$\xa6&\x05%\xe4\xee\x8f\xe8ý˙\xf9\u007f\xfc\x18\xca\xc7\xfa\xf5%\xa3\xbc\xa9C \xc3\n\xbfr\x8b\x91\x16\xbcy\xae\xd1{\xf7\xd5\xf6\x15\x0e\xc1\x81\xf7\xd2\xcc\x0ea\x0f\x9b'B\xc9\xd9\x10\a\u007f\xff`&\xc01\xe2\x94\xc0\xd6\x1fi\x84\xba\x13a\x84\xbe\xec\xbd\xd0p\xc7\xebw{x\xd1G\xfb\xc9:6&ᴨeE8\x9d\x88\x93ڪS1\xb0\x91W\xd8.W\vN\x15\x99\x166W\xbe\f\x81|\xc3\xcew\v1\xbe!*\x13\xcc9\xd1B\xd6\xf9\xec\xcb\xc4sr\xd1\xe2x\xda#\x00\x00\xff\xff\xf7ݣ\x98;\x01\x00\x00",
-		Mtime: 1536106557,
-		Size:  315,
-		Hash:  "064a3d2d4bcceff62b07da8ace7f99c64638e51dfc9eeead733b6d9a1f5e8d69",
-	},
-	"templates/message_prefix.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x90Aj\xc30\x10E\xd7\x0e\xe4\x0eb\x0e\xa0\xb4Х\x14Hlc\x02\xc6\x0e\xaa\xba\xeaJ\x8e\xa7\x8e\xa8\"\xb9\xb6\x02)Bw/rZ\xdaUWÇϛ7\x13\u008c\xdek;\xcc\x04\xac둎\x13\xbe\xe9\x1b\xc4\x18\x02}\xb1\xfa\xe3\x8a1f\xaf\xf3Y\x8d\xc8G\xa3\xb4\xf5x\xf3\xc4;g\xbc\x1e9\x84@\xe5\xe7\x881\x021\xaaC\xc3\xd9z\xc5\xe4n_\x97dߊ\xa2\x14\x1c\x1e\x81\xe4e]\xffć{|>\xee\xf2CS-y_\xe5m݊D;9\xe3&\x02\x17\x9cg5 \xed\xd4\xe9}\x98\xdc\xd5\xf6\x10#l\u05eb\x8cI\x91F\xc6dA\xf26a\x1a\x0eO@\x8e\xad\x90\x1cΨz\x9c\xfe#~7\x92\xf0\xae>TM\xaa\xfc\xbe \x1dG\x95у\xfdS\\\xf6e\xacۆ@\x1bu\xc1\x18٦\xbbKld\xb1Hm\xa4\xd8~\x05\x00\x00\xff\xff\xb9\f\x11JK\x01\x00\x00",
-		Mtime: 1536429832,
-		Size:  331,
-		Hash:  "e59f2bf7d00f213e698deee5b7db349b7fa9e4a93c0961dfda9a14a9f8f61c1c",
-	},
-	"templates/message_suffix.tmpl": {
-		Data:  "</TABLE>>];",
-		Mtime: 1535750645,
-		Hash:  "02177f2768fe5a0168d8d94e179b521d5164217e37a36a35f5215946b30d7583",
-	},
-	"templates/missing_node.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x8c\x90OK\xc40\x14\xc4\xcf\n~\x87\x90\x0f\xd0\xea\xd94\xd0\u007f\x94\x85\xd2.\xb5\x9e\xc4Cv\xfbl\x1f\xa6Im\xb2\xb0\x12\xf2ݥ\xddU\xf7\xa4{\x1c\x987\xbf\x99\xe7\x9c\x01kQ\xf5\x86P\xa5;\b\xa6\x19\xde\xf0H\xbdw.xV\xf8q\x00\xefo^\xcc &\x88&)PY8Zb\xb5\x96\x16\xa7\x88:\x17Tb\x04\xef)\x91b\a2b\xac\x8d\x932'I\xdddy\x13\xd1\aJҼ,\xbf\xe5\xfdI>m\xe3tS\x15\xabN\x8a\xb4.\xebf\xc9\xdak\xa9gBG4\x06U\x1f\xec\xc4\xfe\xbd\x9f\xf5Au\xd4{\xcaY\xdbp\xd6fd[7mD\a\x10\x1d\xcc\u007f\x9d\x9f\x1dK\xb7\xb8\xdc\x14\xd5b\xf9]\xbb\xec\b\x84\xc4^]\x18\xf9\xcf\x1e\x16\xb6\x19g\xe1\x8a<a\xaf\xed\xf9\x0fL\x89\x11V\x94\x1d\xd0\x10\xfb9\x01AC\xceQ\x17\xd4p\xfd#篏w\xb7_\x01\x00\x00\xff\xff\xe1\x83u8\xa6\x01\x00\x00",
-		Mtime: 1537220213,
-		Size:  422,
-		Hash:  "88783d32b0838823077f998a4eacb6dee73ad7de28bd0414cdacb157bbc3be98",
-	},
-	"templates/oneof_entry_enum.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xd0\xd1\n\x820\x14\xc6\xf1k\x83\xdeA\xf6\x00\xf3\x05\xa6P\t\x12\x88\v\xd9\v,=\x89\xa4g\xa6\x1b$c\xef\x1e[\xe4U\x17u\xfd\xf1ߏ\x1d&\xeal\xbf\x8b\x98\xc8\xe3cq\xe2%\xafSbm\xa3\x065\xc7D!\xa8\x1b\xbd\xca\xe6\xde\xcd\xca`K\x9c#\x19KD\xfeI\x0e幨|\xb0\x80\xd6=vKL4<5\x95C\xdf!]\xe0a\x00\x1b\b\x99\xb5\x94\xcfm\x8frp\xee\xf7'P\x8e[^\xc9\x11\xfei\xf5:\x85\xf6\xcb\xc7\xfcD\x01\xcd\x18\xf6\v\xafEJ&\xb5\x19\xc4\x03\x113^\x15\xeb\x14T\x13\xd07Βp\xb5W\x00\x00\x00\xff\xff\xa22\xa9\x19<\x01\x00\x00",
-		Mtime: 1536106565,
-		Size:  316,
-		Hash:  "b372f2b764c6ec704648a61c8f97bab7348674cdc144e93b9b585897a869d7d0",
-	},
-	"templates/oneof_entry_message.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xd0\xd1\n\x83 \x14\x80\xe1\xeb\x06{\x87\xf0\x01\xec\x05,\xd8\x16\xc4 r\x84/`u&\xb1Җ\x06\v\xf1݇ƺ\xda\xc5v\xad\xff\xf98\x87\xb0:;\x1e\"\xc2\xf2\xf8\\\\hI\xeb\x14Y۪A\xcd1R\x12\xd4\x1d7\xbc}\x88Y-\xb2CΡ\x8c$,\xff$\xa7\xf2ZT>\xd0`L/\x85\x8e\x91\x81\x97\xc1|\xe8\x85\xc4\x1a\x9e\v\xc8\x16Bf-\xa6s\xd7K>8\xf7\xfb\b\xc9\xc7=\xaf\xf8\b\xff\xb4f\x9dB\xfbe1\xff\x84GК\x8b\xedˍ\xd6,E\x93\xda\x19䍈4\x1ef\xeb\x14\xe0&\xb8\x9bO\x92p\xb8w\x00\x00\x00\xff\xff|\x85\xb1\\?\x01\x00\x00",
-		Mtime: 1536106579,
-		Size:  319,
-		Hash:  "4d2df5a3242413ef6ebfc175dddb156f75221baba678ae57bb78faffebb70588",
-	},
-	"templates/oneof_entry_missing.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xd0\xd1ʃ \x14\xc0\xf1\xeb>\xf8\xde!|\x00{\x01\v\xb6\x051\x88\x1c\xe1\vX9\x91ձ\xa5\xc1B|\xf7\xa1c]\xedb\xbb\xd6\xff\xf9q\x0eam\xf1\xff\x97\x10V\xa6\xc7\xeaDk\xda\xe6ȹ^\x8fzI\x91\x06\xa1\xaf\xb8\xe3\xfdM.z\x85\x01y\x8f\n\x92\xb1\xf2\x9d\x1c\xeasՄ\xc0\bk\x15H\x93\"+\x1e\x16\xf3QI\xc0F\xdcW\x01\xbd\x88\x99s\x98.\x83\x02>z\xff\xfd\b\xe0Ӟ7|\x12\xbf\xb4v\x9bc\xfba\xb1\xf0\x84'e\x8c\x02\x19\xbf\\h\xcbr4\xeb\x9dA\xc1HH\x17`\xb6\xcd\x11\xee\xa2\xfb\xf2I\x16\x0f\xf7\f\x00\x00\xff\xff\xe9\xa0~\x18?\x01\x00\x00",
-		Mtime: 1537289610,
-		Size:  319,
-		Hash:  "e4460bb49d19f27aab322e06dccd5b8ff198924d0c56169cdd892aa3b61180f7",
-	},
-	"templates/oneof_entry_prefix.tmpl": {
-		Data:  "<TR>\r\n\t<TD COLSPAN=\"4\" BGCOLOR=\"{{color \"oneof.background\"}}\" ALIGN=\"{{settings \"text.align.oneof\"}}\">\r\n\t\t{{.Name}}\r\n\t</TD>\r\n</TR>",
-		Mtime: 1535767876,
-		Hash:  "6ecc559670a664a8f92a42166997f45ceb27a593bc34a28cd2c9b2361aadae0e",
-	},
-	"templates/oneof_entry_simple.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\xcf\xd1ʃ \x14\xc0\xf1\xeb>\xf8\xde!|\x00{\x01\v\xb6\x051\x88\x1c\xe2\v\xb8r!3ui\xb0\x10\xdf}h\xac\xab]l\xd7\xe7\xfcϏ\x83(\xa9\xfe\xff2D\xeb\xfc\u061cp\x8bI\t\xbc\xef\xb5\xd4s\x0e\xb4\xe2\xfa\x06\xaf\xac\xbf\x8f\xb3^\xd4\x00B\x00\x15*h\xfdN\x0e\xed\xb9\xe9b`\xb9sB\x8d6\a\x8e?\x1ddR\x8c\nZ\xfeX\xb8\xeayʼ\x87x\x1e\x84b2\x84\xefO(6\xedy\xc7&\xfeK\xebV\x93\xda\x0f\x8f\xc5\x11\xb4b2r۸`BK`\xf4\xae\x80HdHD\x97\xae&\xb9\"\xb1\x1b\x8f\nJ\xaaW\x00\x00\x00\xff\xff\xc3\xf8\xbf\x06<\x01\x00\x00",
-		Mtime: 1536106587,
-		Size:  316,
-		Hash:  "1ed81ac439ed0e73fd6ab82d6b772c862e593c3e81681fa806f859fa108ab7fb",
-	},
-	"templates/oneof_entry_suffix.tmpl": {
-		Data:  "<TR>\r\n\t<TD COLSPAN=\"4\" BGCOLOR=\"{{color \"oneof.background\"}}\"> \r\n\t</TD>\r\n</TR>",
-		Mtime: 1535767891,
-		Hash:  "773d5ba0fdea677214ae4f988737750a61404ebc3d66bb246e7ffd4b0dc21853",
-	},
-	"templates/service_prefix.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x90Kj\xc30\x14E\xc7\x0ed\x0f\xe2-\xc0i\xe9T\n\xc4\x1fL\xc0\xd8AUG\x1d\xc9\xf6\xab#\xaaJ\xae\xad\x94\x80\xd0ދ\x9c\xfeF\x1d\x89\v\x97\xa3s\x9f\xf7\v:\xa7̸\x100v\xc0t\x9a\xf1E]!\x04\xef\xd3'\xa3\xde/\x18B\xf2\xbc\x9c\xe5\x84l\xd2R\x19\x87WG\x9c\xb5ک\x89\x81\xf7i#\xdf0\x04 Zv\xa8\x19\xddn\xa88duI\xb2\x96\x17%gp\x0f$/\xeb\xfa;\xde\xdd\xe2\xe3\xe9\x90\x1f\x9bj\xcdY\x95\xb7u\xcb#\xad\xb7\xda\xce\x04\x16\x9c?T\x8fi'\xfb\xd7q\xb6\x173@\b\xb0\xdfn\x12*x|\x12*\n\x92\xb7\x11\xd30x\x00rj\xb9`pF9\xe0\xfc\x1f\xf1\xab\x11\x85\x0f\xf5\xb1jb\xe5\xf7\x04q\\*\xb5\x1a͟\xe2\xfa_B\xbb\xfd\xcfX\xba\xebn\x12;Q\xacR;\xc1\xf7\x9f\x01\x00\x00\xff\xff\xe5\xc1^FK\x01\x00\x00",
-		Mtime: 1536612399,
-		Size:  331,
-		Hash:  "9a68c9b49445e71a2929ef2c5f84c72ab0926706225d3a9e22afc66817d9a971",
-	},
-	"templates/service_rpc.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x94\x90\xc1j\xc30\x10D\xcf\x0e\xe4\x1f\x84\xee\x95\xe9\xb5Ȇ\xb6\x81P\bqQu/v\xbc\x04\x83-\xa9\xdaM\x89\x11\xfa\xf7\xa2\xd8usKs\x9eݙ7#\xb5*\u05ebL\xea\r{\u07bdm\xf7\x05\x0f\x01\x81\xa83Gd\x9c\xe0L\xa2\ueee3\x11\xa6\x1e\x80\xc7\xc8Kٔ!\x88}=@\x8c2oJ\x99\xeb\xcdl\x91\x84\x0f\xf2P\x0f\xa8\xe0\xeb\x04H\xe9d\x91\xd9{\xa5t\xc1\x9d]\xfe?\xfdt\xc6o\x84\xd3\xe8\xa6\xf0\x10\xc4\xec\xacG\a\x8b\xbb\xcc/5\xa4V\fi\xec\xa1\xe0\x8d\xf5-\xf8\x87\xc6\x12\xd9\xe1\x89=\xba3C\xdbw-\xf3\xd0\xf2_\xdck\xb6\x97\xedk\xb5\xabTB8\xd8\xdez\xc6\x11\xfcww\x00\xe1\x81N\xde\\\xe2\u05eb,\xbb\xee\x98\x04\x8c1Yܨ\x89\xce\x1a\x84\xff\xf6\xbc\x8bfƘ\x06\xf9C\x997\xf9\t\x00\x00\xff\xff\x01\x9a\xc8\xcc\xe1\x01\x00\x00",
-		Mtime: 1536107077,
-		Size:  481,
-		Hash:  "3f063a90f9d02dbb345e2497d8fd01828dc243bd38090b941c1a10fd628ebb19",
-	},
-	"templates/service_suffix.tmpl": {
-		Data:  "</TABLE>>];",
-		Mtime: 1535750645,
-		Hash:  "02177f2768fe5a0168d8d94e179b521d5164217e37a36a35f5215946b30d7583",
-	},
-	"templates/subgraph_begin.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff<\x8eQ\n\xc20\f@\xbf7\xd8\x1dB\x0f\xb0\v\xc8N \x88\xe0\x01\xa4\xeb\xe2:\x8cf\xa4釔\xdc]\xea\x8a\u007f\x8f\xbc\xbc\x90.\xe5y\x15\xbfG\b\x94\x93\xa2\xdcK\x19\xaf\xc2\xca\x17\xff\xc23\xa7\x88b\x06e軎\xfc\x8c\x04\x13\xb8R\xc6[dѺb\xe6\xaaSf\xd2mo\xf6\u007f\xa0٤\x1fB\x98\xe0\xb1\x11\xe1r\xaa\xa3\x8a\x81\x89\xe5H\x0et\xed\x89q\xf6\xe1\xb9\n\xe7\xf7\xe2\xcc\xdc/\x18\xfao\x00\x00\x00\xff\xff+>D\n\xac\x00\x00\x00",
-		Mtime: 1537749763,
-		Size:  172,
-		Hash:  "8dc51023382e7cd850e74fe4f0c145d7903c96c7da9d82dce9744cb84aa3b7e5",
-	},
-	"templates/subgraph_end.tmpl": {
-		Data:  "\t}\r\n\r\n",
-		Mtime: 1535133702,
-		Hash:  "dd3bd0cd0d41ff03aae3b1f56430ae51ffdcad297417a2a1b81009a14d4b1c7e",
-	},
-	"templates/subgraph_entry.tmpl": {
-		Data:  "\t\t{{.}}\r\n",
-		Mtime: 1535750664,
-		Hash:  "c644482bd0324e8cc730dfe825bf3ccbe03a2ea29cc4b26da86e157d6170e409",
-	},
-	"templates/to_enum.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x8d1\n\x021\x10E\xeb\x15\xbc\xc30\xfd\xe6\x00+Zz\x02;\xb1\x10wԁl&$\x11\x84\xe1\xdf]6Xo\xf7\x8a\xff\xdf\x1bܫ\xb4\xa6\xe9U\x89\x93\xcd\x12r\x91\xa7~\x19p\x0f\xe7b\v0e[Y%\xce\xc0$\xc3x\xa2\xcd\xd7\xc5\x00\xba>,Z9\xb2{\a\xe2\"\xf1\xde\xd4R}k\x0e\x92>\v\x03L\xcd,6\xcd\xeb\xee_\xa3\xb1\xfb\xbb\x85o\x87\xfd\xee\x17\x00\x00\xff\xff\xd7?Z\x8c\xa3\x00\x00\x00",
-		Mtime: 1536171725,
-		Size:  163,
-		Hash:  "1662c192a0bb3661a749e1aacd2333b954dd1c5b52d869ac8a20d776999edd73",
-	},
-	"templates/to_message.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x8d1\n\x021\x10E\xeb\x15\xbc\xc30\xfd\xe6\x00\x11-=\x81\x9dX\x043\xee\x0ed3!I!\fsw\xd9`\xbd\xdd+\xfe\u007foRm\xd4;\xe7\xa5\x01f\x89\xe4J\xa5\x0f\u007f\xd1L\xd5ݫlf\xbe\xc8\xceL)\x9ay\x9a\xe6\x1b\x1c\xbe\x1eb\xe6W\n\x91*<ߒ\xa4^Qu\x00`\xa5\x14:Kn+\x17\xb7Qka!4C\xe8\"\xa9s٧\xff.̣4|\xf8\xba\x9cO\xbf\x00\x00\x00\xff\xff\x90\x0e\xfcW\xad\x00\x00\x00",
-		Mtime: 1536260578,
-		Size:  173,
-		Hash:  "b822c6d2f6734cf0179b6ff826ad05571b0accf62e8d23b1745692ef8d3d9017",
-	},
-	"templates/to_missing.tmpl": {
-		Data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff|\x8d1\n\xc2@\x10E\xeb\b\xdea\x98>{\x80\x88\x96\x9e\xc0N,Čq`\xb3\xb3\xecn!\f\xff\xee\x92\xc5:\xdd+\xfe\u007fop\xafҚ\xa6\xa5\x12'\x9b%\xe4\"o\xfd2\xe0\x1e\xae\xc5V`ʶ\xb1J\x9c\x81I\x86\xf1B\xbb\xaf\x9b\x01t\u007fY\xb4rf\xf7\x0e\xc4E⳩\xa5\xfa\xd1\x1cV\xadU\xd3\xc2\x00S3\x8bM\xf36\xfd\ai\xec\x89.\xe2\xc7\xe9x\xf8\x05\x00\x00\xff\xff\x94\x94\x856\xa6\x00\x00\x00",
-		Mtime: 1537220124,
-		Size:  166,
-		Hash:  "bef14f9c107d0dae0ccb2f25684de5accd02ad60f2316e65a5d7dc3d24624031",
-	},
-}
-
-func init() {
-	assets.Assign(staticAssets)
-}
@@ -0,0 +1,339 @@
+// Copyright 2017 Seamia Corporation. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package svg lays out and renders protodot's diagrams (tables connected by edges) as .svg,
+// without the need for graphviz.
+package svg
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+)
+
+// Row is a single line of a node's table
+type Row struct {
+	Cells  []string
+	Port   string // name the edges can start from
+	Fill   string // color of the last cell (or the whole row, if Span)
+	Span   bool   // the only cell spans the whole width of the table
+	Title  string // tooltip
+	Strike bool   // the text is crossed out, e.g. deprecated field
+}
+
+// Node is a table with a header
+type Node struct {
+	ID         string
+	Title      string // tooltip
+	Header     string
+	HeaderFill string
+	Fill       string
+	Rows       []Row
+}
+
+// Edge connects a row (Port) of one node with the header of the other
+type Edge struct {
+	From   string
+	Port   string // empty: the edge starts at the header
+	To     string
+	Color  string
+	Dashed bool
+	Label  string // shown in the middle of the edge
+}
+
+// Graph is everything that is going to be drawn, left to right
+type Graph struct {
+	Label    string
+	FontName string
+	FontSize int
+	Nodes    []*Node
+	Edges    []Edge
+}
+
+const (
+	columnGap = 80
+	nodeGap   = 24
+	margin    = 20
+	padding   = 6
+)
+
+type box struct {
+	x, y, width, height int
+	columns             []int // widths of the columns of the table
+	rank                int
+}
+
+func (g *Graph) fontSize() int {
+	if g.FontSize > 0 {
+		return g.FontSize
+	}
+	return 10
+}
+
+func (g *Graph) rowHeight() int {
+	return g.fontSize() * 2
+}
+
+// rough estimation: there is no font metrics available here
+func (g *Graph) textWidth(text string) int {
+	return len([]rune(text)) * g.fontSize() * 6 / 10
+}
+
+func (g *Graph) measure(node *Node) *box {
+	b := box{}
+	for _, row := range node.Rows {
+		if row.Span {
+			continue
+		}
+		for len(b.columns) < len(row.Cells) {
+			b.columns = append(b.columns, 0)
+		}
+		for index, cell := range row.Cells {
+			if width := g.textWidth(cell) + 2*padding; width > b.columns[index] {
+				b.columns[index] = width
+			}
+		}
+	}
+
+	for _, width := range b.columns {
+		b.width += width
+	}
+	if width := g.textWidth(node.Header) + 2*padding; width > b.width {
+		b.width = width
+	}
+	for _, row := range node.Rows {
+		if row.Span && len(row.Cells) > 0 {
+			if width := g.textWidth(row.Cells[0]) + 2*padding; width > b.width {
+				b.width = width
+			}
+		}
+	}
+
+	b.height = (len(node.Rows) + 1) * g.rowHeight()
+	return &b
+}
+
+// simple layered layout: every node is placed one column to the right of the rightmost node pointing at it
+func (g *Graph) layout() map[string]*box {
+	boxes := make(map[string]*box)
+	for _, node := range g.Nodes {
+		boxes[node.ID] = g.measure(node)
+	}
+
+	// longest path ranking; the number of passes is limited to survive the cycles
+	for pass := 0; pass < len(g.Nodes); pass++ {
+		changed := false
+		for _, edge := range g.Edges {
+			from, to := boxes[edge.From], boxes[edge.To]
+			if from == nil || to == nil || edge.From == edge.To {
+				continue
+			}
+			if to.rank < from.rank+1 {
+				to.rank = from.rank + 1
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	columns := make(map[int][]*Node)
+	maxRank := 0
+	for _, node := range g.Nodes {
+		rank := boxes[node.ID].rank
+		columns[rank] = append(columns[rank], node)
+		if rank > maxRank {
+			maxRank = rank
+		}
+	}
+
+	// average position of the nodes pointing at the given one: keeps the connected nodes close to each other
+	preferred := func(id string) float64 {
+		sum, count := 0, 0
+		for _, edge := range g.Edges {
+			if edge.To == id {
+				if from := boxes[edge.From]; from != nil && from.rank < boxes[id].rank {
+					sum += from.y
+					count++
+				}
+			}
+		}
+		if count == 0 {
+			return 0
+		}
+		return float64(sum) / float64(count)
+	}
+
+	x := margin
+	for rank := 0; rank <= maxRank; rank++ {
+		members := columns[rank]
+		if rank > 0 {
+			sort.SliceStable(members, func(i, j int) bool {
+				return preferred(members[i].ID) < preferred(members[j].ID)
+			})
+		}
+
+		y, width := margin, 0
+		for _, node := range members {
+			b := boxes[node.ID]
+			b.x, b.y = x, y
+			y += b.height + nodeGap
+			if b.width > width {
+				width = b.width
+			}
+		}
+		x += width + columnGap
+	}
+	return boxes
+}
+
+func portY(g *Graph, node *Node, b *box, port string) int {
+	for index, row := range node.Rows {
+		if len(port) > 0 && row.Port == port {
+			return b.y + (index+1)*g.rowHeight() + g.rowHeight()/2
+		}
+	}
+	return b.y + g.rowHeight()/2
+}
+
+func escape(text string) string {
+	return html.EscapeString(text)
+}
+
+// Write lays the graph out and writes it as .svg
+func (g *Graph) Write(w io.Writer) error {
+	boxes := g.layout()
+	fontSize := g.fontSize()
+	rowHeight := g.rowHeight()
+
+	width, height := 0, 0
+	for _, b := range boxes {
+		if b.x+b.width > width {
+			width = b.x + b.width
+		}
+		if b.y+b.height > height {
+			height = b.y + b.height
+		}
+	}
+	width += margin
+	height += margin + rowHeight
+
+	fontName := g.FontName
+	if len(fontName) == 0 {
+		fontName = "sans-serif"
+	}
+
+	out := &writer{w: w}
+	out.printf("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	out.printf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\" font-family=\"%s\" font-size=\"%d\">\n",
+		width, height, width, height, escape(fontName), fontSize)
+	out.printf("<defs><marker id=\"arrow\" viewBox=\"0 0 10 10\" refX=\"10\" refY=\"5\" markerWidth=\"8\" markerHeight=\"8\" orient=\"auto\"><path d=\"M0,0 L10,5 L0,10 z\"/></marker></defs>\n")
+	if len(g.Label) > 0 {
+		out.printf("<text x=\"%d\" y=\"%d\" text-anchor=\"middle\">%s</text>\n", width/2, height-margin/2, escape(g.Label))
+	}
+
+	byID := make(map[string]*Node)
+	for _, node := range g.Nodes {
+		byID[node.ID] = node
+	}
+
+	for _, edge := range g.Edges {
+		from, to := byID[edge.From], byID[edge.To]
+		if from == nil || to == nil {
+			continue
+		}
+		fb, tb := boxes[edge.From], boxes[edge.To]
+		x1, y1 := fb.x+fb.width, portY(g, from, fb, edge.Port)
+		x2, y2 := tb.x, tb.y+rowHeight/2
+		bend := columnGap / 2
+		color := edge.Color
+		if len(color) == 0 {
+			color = "black"
+		}
+		dash := ""
+		if edge.Dashed {
+			dash = " stroke-dasharray=\"5,3\""
+		}
+		out.printf("<path d=\"M%d,%d C%d,%d %d,%d %d,%d\" fill=\"none\" stroke=\"%s\"%s marker-end=\"url(#arrow)\"><title>%s</title></path>\n",
+			x1, y1, x1+bend, y1, x2-bend, y2, x2, y2, escape(color), dash, escape(edge.From+":"+edge.Port+" --> "+edge.To))
+		if len(edge.Label) > 0 {
+			out.printf("<text x=\"%d\" y=\"%d\" text-anchor=\"middle\" fill=\"%s\">%s</text>\n", (x1+x2)/2, (y1+y2)/2-padding/2, escape(color), escape(edge.Label))
+		}
+	}
+
+	for _, node := range g.Nodes {
+		b := boxes[node.ID]
+		out.printf("<g><title>%s</title>\n", escape(node.Title))
+		out.printf("<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\" stroke=\"black\"/>\n", b.x, b.y, b.width, b.height, escape(fillOf(node.Fill)))
+		out.printf("<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", b.x, b.y, b.width, rowHeight, escape(fillOf(node.HeaderFill)))
+		out.printf("<text x=\"%d\" y=\"%d\" text-anchor=\"end\" font-weight=\"bold\">%s</text>\n", b.x+b.width-padding, b.y+rowHeight*2/3, escape(node.Header))
+
+		for index, row := range node.Rows {
+			g.writeRow(out, b, row, b.y+(index+1)*rowHeight)
+		}
+		out.printf("</g>\n")
+	}
+
+	out.printf("</svg>\n")
+	return out.err
+}
+
+func (g *Graph) writeRow(out *writer, b *box, row Row, y int) {
+	rowHeight := g.rowHeight()
+	decoration := ""
+	if row.Strike {
+		decoration = " text-decoration=\"line-through\""
+	}
+	if len(row.Title) > 0 {
+		out.printf("<g><title>%s</title>\n", escape(row.Title))
+		defer out.printf("</g>\n")
+	}
+
+	if row.Span {
+		if len(row.Fill) > 0 {
+			out.printf("<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", b.x, y, b.width, rowHeight, escape(row.Fill))
+		}
+		if len(row.Cells) > 0 {
+			out.printf("<text x=\"%d\" y=\"%d\"%s>%s</text>\n", b.x+padding, y+rowHeight*2/3, decoration, escape(row.Cells[0]))
+		}
+		return
+	}
+
+	x := b.x
+	for column, cell := range row.Cells {
+		cellWidth := b.columns[column]
+		if column == len(row.Cells)-1 {
+			// the last cell takes whatever is left
+			cellWidth = b.x + b.width - x
+			if len(row.Fill) > 0 {
+				out.printf("<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", x, y, cellWidth, rowHeight, escape(row.Fill))
+			}
+		}
+		if len(cell) > 0 {
+			out.printf("<text x=\"%d\" y=\"%d\"%s>%s</text>\n", x+padding, y+rowHeight*2/3, decoration, escape(cell))
+		}
+		x += cellWidth
+	}
+}
+
+func fillOf(color string) string {
+	if len(color) == 0 {
+		return "white"
+	}
+	return color
+}
+
+// remembers the first error, so that the rendering code does not have to check every write
+type writer struct {
+	w   io.Writer
+	err error
+}
+
+func (w *writer) printf(format string, a ...interface{}) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, a...)
+	}
+}